@@ -29,6 +29,19 @@ func (AccessorCamera) SetTracker(tracker tracker.Tracker) {
 	pkgController.cameraSetTracker(tracker)
 }
 
+// Like [AccessorCamera.SetTracker](), but instead of switching
+// trackers abruptly, both the previous and the new tracker are
+// run during the given duration, in ticks, and their outputs are
+// blended progressively. This avoids visible jolts when trackers
+// have very different behaviors or internal state.
+//
+// Starting a new transition or setting a tracker directly will
+// stop any transition in progress. Transitioning to the tracker
+// already in use is equivalent to [AccessorCamera.SetTracker]().
+func (AccessorCamera) TransitionToTracker(tracker tracker.Tracker, duration TicksDuration) {
+	pkgController.cameraTransitionToTracker(tracker, duration)
+}
+
 // Feeds the camera the latest target coordinates to point
 // to. The camera might take a while to reach them, depending
 // on the current [Tracker] behavior.
@@ -39,12 +52,68 @@ func (AccessorCamera) NotifyCoordinates(x, y float64) {
 	pkgController.cameraNotifyCoordinates(x, y)
 }
 
+// A tracking target for [AccessorCamera.NotifyTargets]().
+type Target struct {
+	X, Y float64
+
+	// Relative importance of the target when computing the
+	// weighted center of the group. Zero is treated as 1.0.
+	Weight float64
+
+	// Distance around the target that should remain visible
+	// when automatic group zooming is enabled. See
+	// [AccessorCamera.SetGroupZoomRange]().
+	Radius float64
+}
+
+// Similar to [AccessorCamera.NotifyCoordinates](), but for multiple
+// targets. This is useful in co-op games, fights and others where
+// the camera needs to keep multiple targets in view.
+//
+// The notified coordinates will be the weighted center of all the
+// targets. If a group zoom range has been configured, the zoom level
+// will also be automatically adjusted so all targets remain visible.
+// The results are fed to the current tracker and zoomer as usual.
+//
+// Passing an empty slice has no effect.
+func (AccessorCamera) NotifyTargets(targets []Target) {
+	pkgController.cameraNotifyTargets(targets)
+}
+
+// Sets the zoom range used to keep all targets in view when
+// using [AccessorCamera.NotifyTargets](). By default, the range
+// is (0, 0), which disables automatic group zooming.
+//
+// When the targets are spread apart, the zoom will go down as
+// low as minZoom. When the targets are close together, the zoom
+// will go up to maxZoom.
+func (AccessorCamera) SetGroupZoomRange(minZoom, maxZoom float64) {
+	pkgController.cameraSetGroupZoomRange(minZoom, maxZoom)
+}
+
 // Immediately resets the camera coordinates.
 // Commonly used when changing scenes or maps.
+//
+// If the current tracker implements [tracker.Resetter],
+// its internal state is also reset.
 func (AccessorCamera) ResetCoordinates(x, y float64) {
 	pkgController.cameraResetCoordinates(x, y)
 }
 
+// Sets a distance threshold, in screens, for automatic coordinate
+// resets. When the coordinates passed to [AccessorCamera.NotifyCoordinates]()
+// jump further than the threshold from the previous target,
+// [AccessorCamera.ResetCoordinates]() is invoked instead. This
+// is convenient for doors, respawns and other teleports.
+//
+// Distances are measured independently for each axis, at the
+// current zoom level. Teleports detected while a sequence is
+// playing are applied when the sequence ends, skipping its
+// return blend. Defaults to 0, which disables the feature.
+func (AccessorCamera) SetTeleportThreshold(screens float64) {
+	pkgController.cameraSetTeleportThreshold(screens)
+}
+
 // This method allows updating the [AccessorCamera.Area]()
 // even during [Game].Update(). By default, this happens
 // automatically after [Game].Update(), but flushing the
@@ -84,14 +153,174 @@ func (AccessorCamera) AreaF64() (minX, minY, maxX, maxY float64) {
 	return pkgController.cameraAreaF64()
 }
 
+// --- zones ---
+
+// A camera zone. See [AccessorCamera.SetZones]().
+type Zone struct {
+	// The zone becomes active while the tracking target
+	// is within this area, in world coordinates.
+	Area image.Rectangle
+
+	// Optional bounds that the camera view must stay within
+	// while the zone is active. If the bounds are smaller than
+	// the view, the camera is centered on them. Ignored if empty.
+	Bounds image.Rectangle
+
+	// Optional zoom target for the zone. The previous zoom
+	// target is restored when leaving the zone. Ignored if zero.
+	Zoom float64
+
+	// Optional tracker override for the zone. Ignored if nil.
+	Tracker tracker.Tracker
+
+	// Optional axis locks. When set, the camera center is fixed
+	// to the zone's X and/or Y coordinates for the locked axes.
+	// Locking both axes fixes the camera at a point.
+	LockX, LockY bool
+	X, Y float64
+
+	// Duration of the transition when entering the zone, in
+	// ticks. When leaving a zone to no zone at all, the blend
+	// of the zone being left is used instead.
+	Blend TicksDuration
+}
+
+// Sets the camera zones, which allow having the camera behave
+// differently depending on the region of the world the tracking
+// target is in. The first zone containing the target coordinates
+// becomes active, and its constraints are applied to the target
+// before it's passed to the tracker, blending smoothly on zone
+// changes. Passing nil removes all zones.
+//
+// Zones are not evaluated while a sequence is playing. Changes
+// to the slice after this call are not seen by the camera; call
+// this method again to update the zones.
+func (AccessorCamera) SetZones(zones []Zone) {
+	pkgController.cameraSetZones(zones)
+}
+
+// Returns the index of the currently active zone, or -1
+// if none. See [AccessorCamera.SetZones]().
+func (AccessorCamera) GetActiveZone() int {
+	return pkgController.cameraGetActiveZone()
+}
+
+// --- sequences ---
+
+// Starts playing the given camera sequence. While a sequence is
+// playing, it takes control of the camera position instead of the
+// tracker, and zoom targets set by the sequence override the
+// previous ones. Coordinates notified during the sequence are
+// still stored, and they will be used once the sequence ends.
+//
+// When the sequence ends, the zoom target that was active before
+// the sequence started is restored, and control is returned to
+// the tracker. See [Sequence.SetReturnBlend]() for smoother
+// transitions.
+//
+// Playing a sequence while another one is active replaces it.
+func (AccessorCamera) PlaySequence(sequence *Sequence) {
+	pkgController.cameraPlaySequence(sequence)
+}
+
+// Stops the current sequence, if any, returning control to the
+// tracker as if the sequence had ended normally. Remaining steps
+// are skipped, including callbacks.
+func (AccessorCamera) StopSequence() {
+	pkgController.cameraStopSequence()
+}
+
+// Returns whether a camera sequence is being played.
+// See [AccessorCamera.PlaySequence]().
+func (AccessorCamera) IsPlayingSequence() bool {
+	return pkgController.cameraIsPlayingSequence()
+}
+
 // --- zoom ---
 
 // Sets a new target zoom level. The transition from the current
 // zoom level to the new one is managed by a [zoomer.Zoomer].
+//
+// The zoom level is clamped to the current zoom limits. See
+// [AccessorCamera.SetZoomLimits]().
 func (AccessorCamera) Zoom(newZoomLevel float64) {
 	pkgController.cameraZoom(newZoomLevel)
 }
 
+// Like [AccessorCamera.Zoom](), but anchored on the given logical
+// coordinates: while the zoom transitions to the new level, the
+// camera is also moved so that the anchor point remains at the
+// same position on the screen. This is commonly used to zoom
+// towards the cursor in editors and strategy games:
+//   cursorX, cursorY := ebiten.CursorPosition()
+//   x, y := mipix.Convert().ToLogicalCoords(cursorX, cursorY)
+//   mipix.Camera().ZoomAt(newZoomLevel, x, y)
+//
+// Both the current and the target tracking coordinates are
+// adjusted on each zoom update, so if you are notifying
+// coordinates on every update yourself, you will override the
+// effect. The anchor is released once the zoom reaches its target,
+// or when a new zoom or coordinates reset are requested.
+func (AccessorCamera) ZoomAt(newZoomLevel, x, y float64) {
+	pkgController.cameraZoomAt(newZoomLevel, x, y)
+}
+
+// Sets both the target coordinates and the target zoom level
+// so the given logical area becomes fully visible, with at
+// least the given margin around it. The transition is managed
+// by the current tracker and zoomer, as usual.
+//
+// Since the aspect ratios of the area and the game resolution
+// can be different, the area will be centered and the extra
+// space will be visible on one of the axes.
+func (AccessorCamera) Frame(rect image.Rectangle, margin float64) {
+	pkgController.cameraFrame(rect, margin)
+}
+
+// Sets the minimum and maximum zoom levels. Zoom targets will be
+// clamped to this range, and so will the zoom values produced by
+// the zoomer. By default, the limits are [0.005, 500.0], which
+// are also the widest limits allowed.
+func (AccessorCamera) SetZoomLimits(minZoom, maxZoom float64) {
+	pkgController.cameraSetZoomLimits(minZoom, maxZoom)
+}
+
+// Returns the current zoom limits.
+// See [AccessorCamera.SetZoomLimits]().
+func (AccessorCamera) GetZoomLimits() (minZoom, maxZoom float64) {
+	return pkgController.cameraGetZoomLimits()
+}
+
+// Sets a list of discrete zoom levels to be used with
+// [AccessorCamera.ZoomIn]() and [AccessorCamera.ZoomOut](). The
+// stops must be sorted in strictly increasing order. For example:
+//   mipix.Camera().SetZoomStops([]float64{1.0, 1.5, 2.0, 3.0, 4.0})
+//
+// This is mostly useful for mouse wheel zooming, as stepping
+// through a few pleasant levels tends to look much better than
+// arbitrary floats. The slice is copied.
+func (AccessorCamera) SetZoomStops(stops []float64) {
+	pkgController.cameraSetZoomStops(stops)
+}
+
+// Sets the zoom target to the next zoom stop above the current
+// target. If there's no such stop within the zoom limits, the
+// call has no effect. See [AccessorCamera.SetZoomStops]().
+//
+// Will panic if no zoom stops have been configured.
+func (AccessorCamera) ZoomIn() {
+	pkgController.cameraZoomIn()
+}
+
+// Sets the zoom target to the next zoom stop below the current
+// target. If there's no such stop within the zoom limits, the
+// call has no effect. See [AccessorCamera.SetZoomStops]().
+//
+// Will panic if no zoom stops have been configured.
+func (AccessorCamera) ZoomOut() {
+	pkgController.cameraZoomOut()
+}
+
 // Returns the current [zoomer.Zoomer] interface.
 // See [AccessorCamera.SetZoomer]() for more details.
 func (AccessorCamera) GetZoomer() zoomer.Zoomer {
@@ -105,7 +334,15 @@ func (AccessorCamera) SetZoomer(zoomer zoomer.Zoomer) {
 	pkgController.cameraSetZoomer(zoomer)
 }
 
-// Returns the current and target zoom levels.
+// Like [AccessorCamera.SetZoomer](), but blending the outputs
+// of the previous and new zoomers during the given duration.
+// See [AccessorCamera.TransitionToTracker]() for more details.
+func (AccessorCamera) TransitionToZoomer(zoomer zoomer.Zoomer, duration TicksDuration) {
+	pkgController.cameraTransitionToZoomer(zoomer, duration)
+}
+
+// Returns the current and target zoom levels. Zoom offsets
+// from [shaker.ZoomShaker] are not included in these values.
 func (AccessorCamera) GetZoom() (current, target float64) {
 	return pkgController.cameraGetZoom()
 }
@@ -120,6 +357,9 @@ func (AccessorCamera) GetShaker() shaker.Shaker {
 
 // Sets a shaker. By default the screen shaker interface is
 // nil, and shakes are handled by a fallback [shaker.SimpleShaker].
+//
+// If the shaker also implements [shaker.ZoomShaker], its zoom
+// channel will be applied on top of the current zoom level.
 func (AccessorCamera) SetShaker(shaker shaker.Shaker) {
 	pkgController.cameraSetShaker(shaker)
 }
@@ -151,3 +391,261 @@ func (AccessorCamera) IsShaking() bool {
 func (AccessorCamera) TriggerShake(fadeIn, duration, fadeOut TicksDuration) {
 	pkgController.cameraTriggerShake(fadeIn, duration, fadeOut)
 }
+
+// Like [AccessorCamera.TriggerShake](), but the shake originates
+// from the given logical coordinates. The shake level is attenuated
+// based on the distance between the camera center and (x, y): at
+// the source, the shake has full strength, and as the distance
+// grows, the shake fades out smoothly until reaching zero at
+// the given radius. The attenuation is recomputed on every update,
+// so moving closer or away from the source while the shake is
+// still active will also be reflected.
+//
+// Starting or triggering a regular shake will override a positional
+// one, and vice versa.
+func (AccessorCamera) TriggerShakeAt(x, y, radius float64, fadeIn, duration, fadeOut TicksDuration) {
+	pkgController.cameraTriggerShakeAt(x, y, radius, fadeIn, duration, fadeOut)
+}
+
+// --- kicks ---
+
+// Pushes the camera in the given direction, with a spring
+// bringing it back to its original position afterwards. Kicks
+// are good for explosions, hits and recoil, where random shaking
+// would lose the sense of direction.
+//
+// The dx and dy values are given in logical pixels and indicate
+// the peak displacement of the kick. Kicks are independent from
+// screen shakes, so you can combine them with any [shaker.Shaker]
+// freely. Multiple kicks accumulate.
+func (AccessorCamera) Kick(dx, dy float64) {
+	pkgController.cameraKick(dx, dy)
+}
+
+// Sets the parameters of the spring used for [AccessorCamera.Kick]().
+// Damping values must be in [0.0, 1.0] range, and power must be
+// strictly positive. Lower damping values make the camera bounce
+// more before settling, while higher power values make the kick
+// and recovery faster. Defaults are (0.5, 20.0).
+func (AccessorCamera) SetKickParameters(damping, power float64) {
+	pkgController.cameraSetKickParameters(damping, power)
+}
+
+// Returns whether the camera is still recovering from a kick.
+// See [AccessorCamera.Kick]().
+func (AccessorCamera) IsKicking() bool {
+	return pkgController.cameraIsKicking()
+}
+
+// --- offsets ---
+
+// Sets an offset, in world coordinates, that's applied on top of
+// the tracked camera position. Offsets are meant for gameplay-driven
+// framing, like looking up or down, aiming ahead with a stick, or
+// keeping the player off-center. Unlike moving the target notified
+// through [AccessorCamera.NotifyCoordinates](), offsets don't affect
+// the tracker's notion of target or speed.
+//
+// The transition, in ticks, determines how long it takes to reach
+// the new offset with a quadratic in-out easing. Zero applies the
+// offset immediately.
+func (AccessorCamera) SetOffset(dx, dy float64, transition TicksDuration) {
+	pkgController.cameraSetOffset(dx, dy, transition)
+}
+
+// Returns the target offset set through [AccessorCamera.SetOffset]().
+// The current offset might differ while a transition is in progress.
+func (AccessorCamera) GetOffset() (dx, dy float64) {
+	return pkgController.cameraGetOffset()
+}
+
+// --- events ---
+
+// Sets a callback to be invoked when the zoom reaches its target
+// and comes to rest. If the current zoomer implements [zoomer.Settler],
+// it's also required to report itself as settled. Passing nil
+// removes the callback.
+//
+// Like all camera callbacks, it's invoked at the end of the
+// camera update, and only once per state change.
+func (AccessorCamera) OnZoomSettled(callback func()) {
+	pkgController.cameraOnZoomSettled(callback)
+}
+
+// Sets a callback to be invoked when the camera reaches its
+// tracking target, within the given epsilon distance, in world
+// units. If the current tracker implements [tracker.Settler],
+// its answer is used instead of the epsilon distance check, as
+// trackers like [tracker.Rooms] or [tracker.Rail] don't aim at the
+// target itself. The target considers the effects of zones, but
+// this event is not evaluated while sequences are playing. Passing
+// nil removes the callback.
+func (AccessorCamera) OnTargetReached(epsilon float64, callback func()) {
+	pkgController.cameraOnTargetReached(epsilon, callback)
+}
+
+// Sets a callback to be invoked when a screen shake ends.
+// See [AccessorCamera.IsShaking](). Passing nil removes
+// the callback.
+func (AccessorCamera) OnShakeEnd(callback func()) {
+	pkgController.cameraOnShakeEnd(callback)
+}
+
+// --- pixel snapping ---
+
+// Pixel snapping modes for [AccessorCamera.SetPixelSnapping]().
+type PixelSnapping uint8
+const (
+	// No snapping. The camera can sit at fractional coordinates,
+	// which results in smoother motion. This is the default.
+	PixelSnappingOff PixelSnapping = iota
+
+	// The camera area is always aligned to whole logical pixels.
+	// Motion becomes less smooth, especially at slow speeds,
+	// but the logical canvas is never sampled at fractional
+	// offsets.
+	PixelSnappingAlways
+
+	// The camera area is only aligned to whole logical pixels
+	// when the tracker has come to a stop, keeping smooth motion
+	// while moving and crisp pixels while static.
+	PixelSnappingAtRest
+
+	pixelSnappingEndSentinel
+)
+
+// Returns a string representation of the pixel snapping mode.
+func (self PixelSnapping) String() string {
+	switch self {
+	case PixelSnappingOff    : return "PixelSnappingOff"
+	case PixelSnappingAlways : return "PixelSnappingAlways"
+	case PixelSnappingAtRest : return "PixelSnappingAtRest"
+	default:
+		panic("invalid PixelSnapping")
+	}
+}
+
+// Sets the pixel snapping mode, which determines whether the
+// camera area is aligned to whole logical pixels. Snapping only
+// applies to the camera area; the tracker's internal coordinates
+// remain unaffected. The default is [PixelSnappingOff].
+func (AccessorCamera) SetPixelSnapping(mode PixelSnapping) {
+	pkgController.cameraSetPixelSnapping(mode)
+}
+
+// Returns the current pixel snapping mode.
+// See [AccessorCamera.SetPixelSnapping]().
+func (AccessorCamera) GetPixelSnapping() PixelSnapping {
+	return pkgController.cameraGetPixelSnapping()
+}
+
+// --- state ---
+
+// Returns a snapshot of the current camera state. Snapshots can
+// be restored later through [AccessorCamera.Restore](), which
+// is useful for save games, replays, or returning to a previous
+// scene with the camera exactly where it was.
+func (AccessorCamera) Snapshot() CameraState {
+	return pkgController.cameraSnapshot()
+}
+
+// Restores a camera state previously obtained through
+// [AccessorCamera.Snapshot](). The tick count is not modified,
+// see [AccessorCamera.RestoreTick]() if you also need that.
+//
+// Trackers and zoomers are reset if possible (see [tracker.Resetter]),
+// tracker and zoomer transitions are stopped, and any sequence in
+// progress is dropped. Zoom values are clamped to the current
+// zoom limits.
+func (AccessorCamera) Restore(state CameraState) {
+	pkgController.cameraRestore(state)
+}
+
+// Restores the tick count stored in the given camera state, so
+// [AccessorTick.Now]() will change accordingly. This is only
+// relevant for replays and similar cases where timing must match
+// the original run exactly. Typically used right after
+// [AccessorCamera.Restore]().
+func (AccessorCamera) RestoreTick(state CameraState) {
+	pkgController.cameraRestoreTick(state)
+}
+
+// --- time ---
+
+// Pauses or resumes the camera. While paused, tracking, zooms,
+// shakes, kicks, offsets and sequences don't advance, but
+// notifying coordinates and other camera commands remain valid.
+// [AccessorTick.Now]() is not affected.
+//
+// Pausing is convenient for pause menus and other overlays
+// where the game keeps running Update() but the world is
+// frozen.
+func (AccessorCamera) SetPaused(paused bool) {
+	pkgController.cameraSetPaused(paused)
+}
+
+// Returns whether the camera is paused.
+// See [AccessorCamera.SetPaused]().
+func (AccessorCamera) IsPaused() bool {
+	return pkgController.cameraIsPaused()
+}
+
+// Sets the camera time scale. Values below 1.0 slow down the
+// camera (tracking, zooms, shakes, kicks, offsets and sequences),
+// while values above 1.0 speed it up. [AccessorTick.Now]() is
+// not affected. The scale must be in (0, 16]. Defaults to 1.0.
+//
+// All built-in trackers, zoomers and shakers respect the time
+// scale. Custom implementations relying on [AccessorTick.UPS]()
+// will keep running at normal speed instead.
+func (AccessorCamera) SetTimeScale(scale float64) {
+	pkgController.cameraSetTimeScale(scale)
+}
+
+// Returns the camera time scale.
+// See [AccessorCamera.SetTimeScale]().
+func (AccessorCamera) GetTimeScale() float64 {
+	return pkgController.cameraGetTimeScale()
+}
+
+// Freezes the camera for the given duration, in ticks. This is
+// meant for hit-stop effects, where the action stops for a few
+// frames on strong impacts. Freezes don't accumulate: if the
+// camera is already frozen, the longest remaining duration is
+// kept. Frozen time is not affected by the time scale.
+func (AccessorCamera) Freeze(duration TicksDuration) {
+	pkgController.cameraFreeze(duration)
+}
+
+// Returns whether the camera is currently frozen.
+// See [AccessorCamera.Freeze]().
+func (AccessorCamera) IsFrozen() bool {
+	return pkgController.cameraIsFrozen()
+}
+
+// --- accessibility ---
+
+// Sets the motion reduction level, in [0, 1] range. This is an
+// accessibility setting for players with motion sensitivity, and
+// it's applied centrally, so zoomers and shakers respect it
+// without needing specific support:
+//  - Screen shakes and kicks are scaled down by (1 - reduction).
+//  - Zoom changes that overshoot their target or move away
+//    from it have the excess scaled down by the same factor,
+//    taming bouncy springs and similar.
+//  - The same applies to tracking changes, but only for spring
+//    based trackers ([tracker.Spring] and [tracker.SpringTailer],
+//    including the default tracker). Other trackers are left
+//    untouched, as they might move away from the notified target
+//    by design (e.g. [tracker.Rooms] or [tracker.Rail]).
+// A value of 0 (the default) disables the reduction, while 1
+// disables shakes and overshoots completely.
+func (AccessorCamera) SetMotionReduction(reduction float64) {
+	pkgController.cameraSetMotionReduction(reduction)
+}
+
+// Returns the current motion reduction level.
+// See [AccessorCamera.SetMotionReduction]().
+func (AccessorCamera) GetMotionReduction() float64 {
+	return pkgController.cameraGetMotionReduction()
+}
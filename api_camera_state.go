@@ -0,0 +1,59 @@
+package mipix
+
+// A snapshot of the camera state, obtained through
+// [AccessorCamera.Snapshot]() and restored through
+// [AccessorCamera.Restore](). Can be encoded with
+// encoding/json for save games and replays.
+//
+// Snapshots don't include any configuration (trackers,
+// zoomers, shakers, zones, limits, callbacks...) nor the
+// internal state of those components. Sequences in progress
+// are not included either.
+type CameraState struct {
+	// tracking
+	TargetX float64 `json:"target_x"`
+	TargetY float64 `json:"target_y"`
+	CurrentX float64 `json:"current_x"`
+	CurrentY float64 `json:"current_y"`
+	SpeedX float64 `json:"speed_x"`
+	SpeedY float64 `json:"speed_y"`
+
+	// zoom
+	ZoomCurrent float64 `json:"zoom_current"`
+	ZoomTarget float64 `json:"zoom_target"`
+
+	// shake
+	ShakeElapsed float64 `json:"shake_elapsed"` // in ticks, fractional if time scaled
+	ShakeFadeIn TicksDuration `json:"shake_fade_in"`
+	ShakeDuration TicksDuration `json:"shake_duration"`
+	ShakeFadeOut TicksDuration `json:"shake_fade_out"`
+	ShakeOffsetX float64 `json:"shake_offset_x"`
+	ShakeOffsetY float64 `json:"shake_offset_y"`
+	ShakeZoom float64 `json:"shake_zoom"`
+	ShakeSourceX float64 `json:"shake_source_x"`
+	ShakeSourceY float64 `json:"shake_source_y"`
+	ShakeRadius float64 `json:"shake_radius"`
+
+	// kicks
+	KickOffsetX float64 `json:"kick_offset_x"`
+	KickOffsetY float64 `json:"kick_offset_y"`
+	KickSpeedX float64 `json:"kick_speed_x"`
+	KickSpeedY float64 `json:"kick_speed_y"`
+
+	// offsets
+	OffsetX float64 `json:"offset_x"`
+	OffsetY float64 `json:"offset_y"`
+	OffsetFromX float64 `json:"offset_from_x"`
+	OffsetFromY float64 `json:"offset_from_y"`
+	OffsetTargetX float64 `json:"offset_target_x"`
+	OffsetTargetY float64 `json:"offset_target_y"`
+	OffsetElapsed float64 `json:"offset_elapsed"` // in ticks, fractional if time scaled
+	OffsetDuration TicksDuration `json:"offset_duration"`
+
+	// time
+	FreezeLeft TicksDuration `json:"freeze_left"`
+
+	// ticks (only restored through AccessorCamera.RestoreTick)
+	Tick uint64 `json:"tick"`
+	Flushed bool `json:"flushed"` // whether the camera had already been updated for Tick
+}
@@ -0,0 +1,134 @@
+package mipix
+
+// Sequences are lists of camera steps for cutscenes and other
+// scripted camera motions. See [AccessorCamera.PlaySequence]().
+//
+// Steps are played in order. Pans and holds take time, while
+// zooms, shakes and callbacks are instantaneous, so they can
+// be used to trigger effects that will overlap with the next
+// timed steps. For example:
+//   var seq mipix.Sequence
+//   seq.Zoom(2.0).Pan(320, 180, 120).Hold(60)
+//   seq.Shake(0, 30, 20).PanCurve(400, 100, 480, 180, 90)
+//   seq.Call(onCutsceneEnd)
+//   mipix.Camera().PlaySequence(&seq)
+//
+// Sequences can be reused, but they must not be modified
+// while being played.
+type Sequence struct {
+	steps []sequenceStep
+	easing func(float64) float64
+	returnBlend TicksDuration
+}
+
+type sequenceStepKind uint8
+const (
+	sequenceStepPan sequenceStepKind = iota
+	sequenceStepHold
+	sequenceStepZoom
+	sequenceStepShake
+	sequenceStepCall
+)
+
+type sequenceStep struct {
+	kind sequenceStepKind
+	duration TicksDuration
+	controlPoints uint8 // for pans, 0 (linear), 1 (quadratic) or 2 (cubic)
+	ctrl1X, ctrl1Y float64
+	ctrl2X, ctrl2Y float64
+	x, y float64 // pan destination or zoom level in x
+	fadeIn, fadeOut TicksDuration
+	callback func()
+}
+
+func (self *sequenceStep) isTimed() bool {
+	return self.kind == sequenceStepPan || self.kind == sequenceStepHold
+}
+
+// Adds a step that moves the camera in a straight line from its
+// current position to the given logical coordinates, over the
+// given duration in ticks.
+func (self *Sequence) Pan(x, y float64, duration TicksDuration) *Sequence {
+	self.steps = append(self.steps, sequenceStep{
+		kind: sequenceStepPan, duration: duration, x: x, y: y,
+	})
+	return self
+}
+
+// Like [Sequence.Pan](), but following a quadratic Bézier curve
+// with the given control point.
+func (self *Sequence) PanCurve(ctrlX, ctrlY, x, y float64, duration TicksDuration) *Sequence {
+	self.steps = append(self.steps, sequenceStep{
+		kind: sequenceStepPan, duration: duration, x: x, y: y,
+		controlPoints: 1, ctrl1X: ctrlX, ctrl1Y: ctrlY,
+	})
+	return self
+}
+
+// Like [Sequence.Pan](), but following a cubic Bézier curve
+// with the given control points.
+func (self *Sequence) PanBezier(ctrl1X, ctrl1Y, ctrl2X, ctrl2Y, x, y float64, duration TicksDuration) *Sequence {
+	self.steps = append(self.steps, sequenceStep{
+		kind: sequenceStepPan, duration: duration, x: x, y: y,
+		controlPoints: 2, ctrl1X: ctrl1X, ctrl1Y: ctrl1Y, ctrl2X: ctrl2X, ctrl2Y: ctrl2Y,
+	})
+	return self
+}
+
+// Adds a step that keeps the camera still for the given duration.
+func (self *Sequence) Hold(duration TicksDuration) *Sequence {
+	self.steps = append(self.steps, sequenceStep{ kind: sequenceStepHold, duration: duration })
+	return self
+}
+
+// Adds an instantaneous step that sets a new zoom target. The
+// transition is managed by the current zoomer, in parallel
+// with the following steps.
+func (self *Sequence) Zoom(zoomLevel float64) *Sequence {
+	self.steps = append(self.steps, sequenceStep{ kind: sequenceStepZoom, x: zoomLevel })
+	return self
+}
+
+// Adds an instantaneous step that triggers a screen shake.
+// See [AccessorCamera.TriggerShake]().
+func (self *Sequence) Shake(fadeIn, duration, fadeOut TicksDuration) *Sequence {
+	self.steps = append(self.steps, sequenceStep{
+		kind: sequenceStepShake, duration: duration, fadeIn: fadeIn, fadeOut: fadeOut,
+	})
+	return self
+}
+
+// Adds an instantaneous step that invokes the given function.
+// This can be used for completion callbacks or to synchronize
+// other game events with the sequence.
+func (self *Sequence) Call(callback func()) *Sequence {
+	if callback == nil { panic("sequence callback can't be nil") }
+	self.steps = append(self.steps, sequenceStep{ kind: sequenceStepCall, callback: callback })
+	return self
+}
+
+// Sets the easing function applied to the progress of each pan
+// step, from its start position (t = 0) to its destination (t = 1).
+// Hold steps are not affected. Defaults to quadratic in-out, which
+// is also used if nil is passed.
+func (self *Sequence) SetEasing(easing func(t float64) float64) {
+	self.easing = easing
+}
+
+// When a sequence ends, control is returned to the camera tracker.
+// To make the transition smooth, the tracking target is blended
+// from the final sequence position to the real target over the
+// given duration, in ticks. Defaults to 0 (immediate).
+func (self *Sequence) SetReturnBlend(blend TicksDuration) {
+	self.returnBlend = blend
+}
+
+// Returns the total duration of the sequence, in ticks, not
+// including the return blend.
+func (self *Sequence) Duration() TicksDuration {
+	var total TicksDuration
+	for _, step := range self.steps {
+		if step.isTimed() { total += step.duration }
+	}
+	return total
+}
@@ -13,13 +13,49 @@ func (self *controller) cameraAreaGet() image.Rectangle {
 }
 
 func (self *controller) cameraAreaF64() (minX, minY, maxX, maxY float64) {
-	zoomedWidth  := float64(self.logicalWidth )/self.zoomCurrent
-	zoomedHeight := float64(self.logicalHeight)/self.zoomCurrent
-	minX = self.trackerCurrentX - zoomedWidth /2.0 + self.shakeOffsetX
-	minY = self.trackerCurrentY - zoomedHeight/2.0 + self.shakeOffsetY
+	zoom := self.cameraEffectiveZoom()
+	zoomedWidth  := float64(self.logicalWidth )/zoom
+	zoomedHeight := float64(self.logicalHeight)/zoom
+	minX = self.trackerCurrentX - zoomedWidth /2.0 + self.shakeOffsetX + self.kickOffsetX + self.offsetX
+	minY = self.trackerCurrentY - zoomedHeight/2.0 + self.shakeOffsetY + self.kickOffsetY + self.offsetY
+	if self.shouldSnapToPixels() {
+		minX, minY = math.Round(minX), math.Round(minY)
+	}
 	return minX, minY, minX + zoomedWidth, minY + zoomedHeight
 }
 
+func (self *controller) shouldSnapToPixels() bool {
+	switch self.pixelSnapping {
+	case PixelSnappingOff:
+		return false
+	case PixelSnappingAlways:
+		return true
+	case PixelSnappingAtRest:
+		return self.trackerPrevSpeedX == 0 && self.trackerPrevSpeedY == 0
+	default:
+		panic("invalid PixelSnapping")
+	}
+}
+
+func (self *controller) cameraSetPixelSnapping(mode PixelSnapping) {
+	if self.inDraw { panic("can't set pixel snapping during draw stage") }
+	if mode >= pixelSnappingEndSentinel { panic("invalid PixelSnapping") }
+	if mode == self.pixelSnapping { return }
+	self.pixelSnapping = mode
+	if self.redrawManaged { self.needsRedraw = true }
+	self.updateCameraArea()
+}
+
+func (self *controller) cameraGetPixelSnapping() PixelSnapping {
+	return self.pixelSnapping
+}
+
+// Returns the current zoom with the shake zoom channel applied.
+func (self *controller) cameraEffectiveZoom() float64 {
+	if self.shakeZoom == 0.0 { return self.zoomCurrent }
+	return self.zoomCurrent*(1.0 + self.shakeZoom)
+}
+
 func (self *controller) updateCameraArea() {
 	minX, minY, maxX, maxY := self.cameraAreaF64()
 	self.cameraArea = image.Rect(
@@ -37,42 +73,164 @@ func (self *controller) cameraGetTracker() tracker.Tracker {
 func (self *controller) cameraSetTracker(tracker tracker.Tracker) {
 	if self.inDraw { panic("can't set tracker during draw stage") }
 	self.tracker = tracker
+	self.trackerTransitionFrom = nil
+}
+
+func (self *controller) cameraTransitionToTracker(tracker tracker.Tracker, duration TicksDuration) {
+	if self.inDraw { panic("can't set tracker during draw stage") }
+	from := self.cameraGetInternalTracker()
+	self.cameraSetTracker(tracker)
+	if duration == 0 || from == self.cameraGetInternalTracker() { return }
+	self.trackerTransitionFrom = from
+	self.trackerTransitionElapsed = 0
+	self.trackerTransitionDuration = duration
 }
 
 func (self *controller) cameraNotifyCoordinates(x, y float64) {
 	if self.inDraw { panic("can't notify tracking coordinates during draw stage") }
+	if self.teleportThreshold > 0 {
+		maxDistX := self.teleportThreshold*float64(self.logicalWidth )/self.zoomCurrent
+		maxDistY := self.teleportThreshold*float64(self.logicalHeight)/self.zoomCurrent
+		if math.Abs(x - self.trackerTargetX) > maxDistX || math.Abs(y - self.trackerTargetY) > maxDistY {
+			if self.sequence != nil { // defer the reset until the sequence ends
+				self.teleportPending = true
+			} else {
+				self.cameraResetCoordinates(x, y)
+				return
+			}
+		}
+	}
 	self.trackerTargetX, self.trackerTargetY = x, y
 }
 
+func (self *controller) cameraSetTeleportThreshold(screens float64) {
+	if self.inDraw { panic("can't set teleport threshold during draw stage") }
+	if screens < 0 { panic("teleport threshold can't be negative") }
+	self.teleportThreshold = screens
+}
+
+// Resets the internal state of the active trackers,
+// if they implement the tracker.Resetter interface.
+func (self *controller) resetTrackers() {
+	if resetter, ok := self.cameraGetInternalTracker().(tracker.Resetter); ok {
+		resetter.Reset()
+	}
+	if resetter, ok := self.trackerTransitionFrom.(tracker.Resetter); ok {
+		resetter.Reset()
+	}
+}
+
+func (self *controller) cameraNotifyTargets(targets []Target) {
+	if self.inDraw { panic("can't notify tracking targets during draw stage") }
+	if len(targets) == 0 { return }
+
+	// compute weighted center
+	var sumX, sumY, sumWeights float64
+	for _, target := range targets {
+		weight := target.Weight
+		if weight < 0 { panic("target weights can't be negative") }
+		if weight == 0 { weight = 1.0 }
+		sumX += target.X*weight
+		sumY += target.Y*weight
+		sumWeights += weight
+	}
+	centerX, centerY := sumX/sumWeights, sumY/sumWeights
+	self.cameraNotifyCoordinates(centerX, centerY)
+	if self.groupZoomMax == 0 { return }
+
+	// compute the zoom required to keep all targets in view
+	var maxDistX, maxDistY float64
+	for _, target := range targets {
+		radius := max(target.Radius, 0)
+		maxDistX = max(maxDistX, internal.Abs(target.X - centerX) + radius)
+		maxDistY = max(maxDistY, internal.Abs(target.Y - centerY) + radius)
+	}
+	zoom := self.groupZoomMax
+	if maxDistX > 0 { zoom = min(zoom, float64(self.logicalWidth )/(maxDistX*2.0)) }
+	if maxDistY > 0 { zoom = min(zoom, float64(self.logicalHeight)/(maxDistY*2.0)) }
+	self.cameraZoom(max(zoom, self.groupZoomMin))
+}
+
+func (self *controller) cameraSetGroupZoomRange(minZoom, maxZoom float64) {
+	if self.inDraw { panic("can't set group zoom range during draw stage") }
+	if minZoom == 0 && maxZoom == 0 {
+		self.groupZoomMin, self.groupZoomMax = 0, 0
+		return
+	}
+	if minZoom <= 0 { panic("minZoom must be strictly positive") }
+	if maxZoom < minZoom { panic("maxZoom must be >= minZoom") }
+	self.groupZoomMin, self.groupZoomMax = minZoom, maxZoom
+}
+
 func (self *controller) cameraResetCoordinates(x, y float64) {
 	if self.inDraw { panic("can't reset camera coordinates during draw stage") }
-	self.trackerTargetX , self.trackerTargetY  = x, y
-	self.trackerCurrentX, self.trackerCurrentY = x, y
+	self.trackerTargetX, self.trackerTargetY = x, y
+	self.trackerBlendDuration = 0
+	self.teleportPending = false
+	x, y = self.resetZoneTarget(x, y)
 	if self.redrawManaged && (x != self.trackerCurrentX || y != self.trackerCurrentY) {
 		self.needsRedraw = true
 	}
+	self.trackerCurrentX, self.trackerCurrentY = x, y
+	self.trackerPrevSpeedX, self.trackerPrevSpeedY = 0.0, 0.0
+	self.zoomAnchored = false
+	self.resetTrackers()
 	self.updateCameraArea()
 }
 
 func (self *controller) cameraFlushCoordinates() {
 	if self.lastFlushCoordinatesTick == self.currentTick { return }
 	self.lastFlushCoordinatesTick = self.currentTick
+	if !self.advanceCameraTime() { return }
+	internal.CurrentTimeScale = self.timeScale
+	if self.sequence == nil {
+		self.updateZones()
+	}
 	self.updateZoom()
-	self.updateTracking()
+	if self.sequence != nil {
+		self.updateSequence()
+	} else {
+		self.updateTracking()
+	}
 	self.updateShake()
+	self.updateKick()
+	self.updateOffset()
 	self.updateCameraArea()
+	internal.CurrentTimeScale = 1.0
+	self.notifyEvents()
 }
 
 func (self *controller) updateTracking() {
+	targetX, targetY := self.getTrackingTarget()
+	targetX, targetY = self.getZoneTarget(targetX, targetY)
 	camTracker := self.cameraGetInternalTracker()
 	changeX, changeY := camTracker.Update(
 		self.trackerCurrentX, self.trackerCurrentY,
-		self.trackerTargetX, self.trackerTargetY,
+		targetX, targetY,
 		self.trackerPrevSpeedX, self.trackerPrevSpeedY,
 	)
+	if self.trackerTransitionFrom != nil {
+		fromChangeX, fromChangeY := self.trackerTransitionFrom.Update(
+			self.trackerCurrentX, self.trackerCurrentY,
+			targetX, targetY,
+			self.trackerPrevSpeedX, self.trackerPrevSpeedY,
+		)
+		self.trackerTransitionElapsed += self.tickAdvance
+		if self.trackerTransitionElapsed >= float64(self.trackerTransitionDuration) {
+			self.trackerTransitionFrom = nil
+		} else {
+			t := self.trackerTransitionElapsed/float64(self.trackerTransitionDuration)
+			changeX = internal.CubicSmoothstepInterp(fromChangeX, changeX, t)
+			changeY = internal.CubicSmoothstepInterp(fromChangeY, changeY, t)
+		}
+	}
+	if self.isTrackerSpringBased(camTracker) && self.isTrackerSpringBased(self.trackerTransitionFrom) {
+		changeX = self.dampenOvershoot(self.trackerCurrentX, targetX, changeX)
+		changeY = self.dampenOvershoot(self.trackerCurrentY, targetY, changeY)
+	}
 	self.trackerCurrentX += changeX
 	self.trackerCurrentY += changeY
-	updateDelta := 1.0/float64(Tick().UPS())
+	updateDelta := internal.GetUpdateDelta()
 	self.trackerPrevSpeedX = changeX/updateDelta
 	self.trackerPrevSpeedY = changeY/updateDelta
 	
@@ -81,7 +239,22 @@ func (self *controller) updateTracking() {
 	}
 }
 
+// Returns the target coordinates to be passed to the tracker,
+// which might differ from the notified ones while blending back
+// from a sequence, and advances the blend.
+func (self *controller) getTrackingTarget() (float64, float64) {
+	if self.trackerBlendElapsed >= float64(self.trackerBlendDuration) {
+		return self.trackerTargetX, self.trackerTargetY
+	}
+	self.trackerBlendElapsed += self.tickAdvance
+	t := internal.QuadInOut(min(self.trackerBlendElapsed/float64(self.trackerBlendDuration), 1.0))
+	x := internal.LinearInterp(self.trackerBlendFromX, self.trackerTargetX, t)
+	y := internal.LinearInterp(self.trackerBlendFromY, self.trackerTargetY, t)
+	return x, y
+}
+
 func (self *controller) cameraGetInternalTracker() tracker.Tracker {
+	if self.zoneTracker != nil { return self.zoneTracker }
 	if self.tracker != nil { return self.tracker }
 	if defaultTracker == nil {
 		defaultTracker = &tracker.SpringTailer{}
@@ -96,11 +269,25 @@ func (self *controller) cameraGetInternalTracker() tracker.Tracker {
 func (self *controller) updateZoom() {
 	zoomer := self.cameraGetInternalZoomer()
 	change := zoomer.Update(self.zoomCurrent, self.zoomTarget)
+	if self.zoomerTransitionFrom != nil {
+		fromChange := self.zoomerTransitionFrom.Update(self.zoomCurrent, self.zoomTarget)
+		self.zoomerTransitionElapsed += self.tickAdvance
+		if self.zoomerTransitionElapsed >= float64(self.zoomerTransitionDuration) {
+			self.zoomerTransitionFrom = nil
+		} else {
+			t := self.zoomerTransitionElapsed/float64(self.zoomerTransitionDuration)
+			change = internal.CubicSmoothstepInterp(fromChange, change, t)
+		}
+	}
 	if math.IsNaN(change) { panic("zoomer returned NaN") }
-	self.zoomCurrent += change
+	change = self.dampenOvershoot(self.zoomCurrent, self.zoomTarget, change)
+	prevZoom := self.zoomCurrent
+	self.zoomCurrent = internal.Clamp(self.zoomCurrent + change, self.zoomMin, self.zoomMax)
+	change = self.zoomCurrent - prevZoom
 	internal.CurrentZoom = self.zoomCurrent
-	if self.zoomCurrent < 0.005 || self.zoomCurrent > 500.0 {
-		panic("something is wrong with the zoomer: after last update, zoom went outside [0.005, 500.0]")
+	if self.zoomAnchored {
+		self.applyZoomAnchor(prevZoom)
+		if self.zoomCurrent == self.zoomTarget { self.zoomAnchored = false }
 	}
 	
 	if self.redrawManaged && change != 0 {
@@ -108,6 +295,20 @@ func (self *controller) updateZoom() {
 	}
 }
 
+// Adjusts the tracker coordinates so the zoom anchor
+// remains at the same position on the screen.
+func (self *controller) applyZoomAnchor(prevZoom float64) {
+	if prevZoom == self.zoomCurrent { return }
+	ratio := prevZoom/self.zoomCurrent
+	// the anchor is given in world coordinates, which already include
+	// the camera offset, so we remove it to operate in tracker space
+	anchorX, anchorY := self.zoomAnchorX - self.offsetX, self.zoomAnchorY - self.offsetY
+	self.trackerCurrentX = anchorX - (anchorX - self.trackerCurrentX)*ratio
+	self.trackerCurrentY = anchorY - (anchorY - self.trackerCurrentY)*ratio
+	self.trackerTargetX  = anchorX - (anchorX - self.trackerTargetX )*ratio
+	self.trackerTargetY  = anchorY - (anchorY - self.trackerTargetY )*ratio
+}
+
 func (self *controller) cameraGetInternalZoomer() zoomer.Zoomer {
 	if self.zoomer != nil { return self.zoomer }
 	if defaultZoomer == nil {
@@ -121,17 +322,37 @@ func (self *controller) updateShake() {
 	if self.cameraIsShaking() {
 		self.shakeWasActive = true
 		activity := self.getShakeActivity()
-		shakeX, shakeY := self.cameraGetInternalShaker().GetShakeOffsets(activity)
-		self.shakeElapsed += TicksDuration(self.tickRate)
-		if self.redrawManaged && (shakeX != self.shakeOffsetX || shakeY != self.shakeOffsetY) {
+		if self.shakeRadius > 0 {
+			activity *= self.getShakeFalloff()
+		}
+		var shakeX, shakeY, shakeZoom float64
+		if activity != 0.0 { // level 0 is reserved for termination calls
+			camShaker := self.cameraGetInternalShaker()
+			shakeX, shakeY = camShaker.GetShakeOffsets(activity)
+			if zoomShaker, ok := camShaker.(shaker.ZoomShaker); ok {
+				shakeZoom = internal.Clamp(zoomShaker.GetShakeZoom(activity), -0.5, 1.0)
+			}
+		}
+		if self.motionReduction != 0.0 {
+			attenuation := 1.0 - self.motionReduction
+			shakeX, shakeY, shakeZoom = shakeX*attenuation, shakeY*attenuation, shakeZoom*attenuation
+		}
+		self.shakeElapsed += self.tickAdvance
+		if self.redrawManaged && (shakeX != self.shakeOffsetX || shakeY != self.shakeOffsetY || shakeZoom != self.shakeZoom) {
 			self.needsRedraw = true
 		}
 		self.shakeOffsetX, self.shakeOffsetY = shakeX, shakeY
+		self.shakeZoom = shakeZoom
 	} else {
 		if self.shakeWasActive {
-			self.cameraGetInternalShaker().GetShakeOffsets(0.0) // termination call
-			if self.shakeOffsetX != 0.0 || self.shakeOffsetY != 0.0 {
+			camShaker := self.cameraGetInternalShaker()
+			camShaker.GetShakeOffsets(0.0) // termination call
+			if zoomShaker, ok := camShaker.(shaker.ZoomShaker); ok {
+				zoomShaker.GetShakeZoom(0.0) // termination call
+			}
+			if self.shakeOffsetX != 0.0 || self.shakeOffsetY != 0.0 || self.shakeZoom != 0.0 {
 				self.shakeOffsetX, self.shakeOffsetY = 0.0, 0.0
+				self.shakeZoom = 0.0
 				self.needsRedraw = true
 			}
 		}
@@ -149,15 +370,89 @@ func (self *controller) cameraGetInternalShaker() shaker.Shaker {
 
 func (self *controller) cameraZoom(newZoomLevel float64) {
 	if self.inDraw { panic("can't zoom during draw stage") }
-	self.zoomTarget = newZoomLevel
+	if math.IsNaN(newZoomLevel) { panic("zoom level can't be NaN") }
+	self.zoomTarget = internal.Clamp(newZoomLevel, self.zoomMin, self.zoomMax)
+	self.zoomAnchored = false
+}
+
+func (self *controller) cameraZoomAt(newZoomLevel, x, y float64) {
+	if self.inDraw { panic("can't zoom during draw stage") }
+	self.cameraZoom(newZoomLevel)
+	self.zoomAnchored = true
+	self.zoomAnchorX, self.zoomAnchorY = x, y
+}
+
+func (self *controller) cameraFrame(rect image.Rectangle, margin float64) {
+	if self.inDraw { panic("can't frame camera during draw stage") }
+	if self.logicalWidth == 0 { panic("must set the game resolution before framing") }
+	width  := float64(rect.Dx()) + margin*2.0
+	height := float64(rect.Dy()) + margin*2.0
+	if width <= 0 || height <= 0 { panic("can't frame an empty area") }
+	centerX := float64(rect.Min.X + rect.Max.X)/2.0
+	centerY := float64(rect.Min.Y + rect.Max.Y)/2.0
+	zoom := min(float64(self.logicalWidth)/width, float64(self.logicalHeight)/height)
+	self.cameraNotifyCoordinates(centerX, centerY)
+	self.cameraZoom(zoom)
 }
 
 func (self *controller) cameraZoomReset(zoomLevel float64) {
 	if self.inDraw { panic("can't reset zoom during draw stage") }
+	zoomLevel = internal.Clamp(zoomLevel, self.zoomMin, self.zoomMax)
 	self.zoomCurrent, self.zoomTarget, internal.CurrentZoom = zoomLevel, zoomLevel, zoomLevel
+	self.zoomAnchored = false
 	self.cameraGetInternalZoomer().Reset()
 }
 
+const zoomHardMin = 0.005
+const zoomHardMax = 500.0
+
+func (self *controller) cameraSetZoomLimits(minZoom, maxZoom float64) {
+	if self.inDraw { panic("can't set zoom limits during draw stage") }
+	if minZoom < zoomHardMin { panic("minZoom can't be < 0.005") }
+	if maxZoom > zoomHardMax { panic("maxZoom can't be > 500.0") }
+	if maxZoom < minZoom { panic("maxZoom must be >= minZoom") }
+	self.zoomMin, self.zoomMax = minZoom, maxZoom
+	self.zoomTarget = internal.Clamp(self.zoomTarget, minZoom, maxZoom)
+}
+
+func (self *controller) cameraGetZoomLimits() (minZoom, maxZoom float64) {
+	return self.zoomMin, self.zoomMax
+}
+
+func (self *controller) cameraSetZoomStops(stops []float64) {
+	if self.inDraw { panic("can't set zoom stops during draw stage") }
+	for i, stop := range stops {
+		if stop <= 0 { panic("zoom stops must be strictly positive") }
+		if i > 0 && stop <= stops[i - 1] {
+			panic("zoom stops must be sorted in strictly increasing order")
+		}
+	}
+	self.zoomStops = append(self.zoomStops[ : 0], stops...)
+}
+
+func (self *controller) cameraZoomIn() {
+	if len(self.zoomStops) == 0 { panic("can't zoom in without zoom stops") }
+	for _, stop := range self.zoomStops {
+		if stop > self.zoomMax { return }
+		if stop > self.zoomTarget && stop >= self.zoomMin {
+			self.cameraZoom(stop)
+			return
+		}
+	}
+}
+
+func (self *controller) cameraZoomOut() {
+	if len(self.zoomStops) == 0 { panic("can't zoom out without zoom stops") }
+	for i := len(self.zoomStops) - 1; i >= 0; i-- {
+		stop := self.zoomStops[i]
+		if stop < self.zoomMin { return }
+		if stop < self.zoomTarget && stop <= self.zoomMax {
+			self.cameraZoom(stop)
+			return
+		}
+	}
+}
+
 func (self *controller) cameraGetZoomer() zoomer.Zoomer {
 	return self.zoomer
 }
@@ -165,6 +460,17 @@ func (self *controller) cameraGetZoomer() zoomer.Zoomer {
 func (self *controller) cameraSetZoomer(zoomer zoomer.Zoomer) {
 	if self.inDraw { panic("can't change zoomer during draw stage") }
 	self.zoomer = zoomer
+	self.zoomerTransitionFrom = nil
+}
+
+func (self *controller) cameraTransitionToZoomer(zoomer zoomer.Zoomer, duration TicksDuration) {
+	if self.inDraw { panic("can't change zoomer during draw stage") }
+	from := self.cameraGetInternalZoomer()
+	self.cameraSetZoomer(zoomer)
+	if duration == 0 || from == self.cameraGetInternalZoomer() { return }
+	self.zoomerTransitionFrom = from
+	self.zoomerTransitionElapsed = 0
+	self.zoomerTransitionDuration = duration
 }
 
 func (self *controller) cameraGetZoom() (current, target float64) {
@@ -188,16 +494,17 @@ func (self *controller) cameraStartShake(fadeIn TicksDuration) {
 	self.shakeFadeIn = fadeIn
 	self.shakeDuration = maxUint32
 	self.shakeFadeOut = 0
-	self.shakeElapsed = TicksDuration(float64(fadeIn)*activity)
+	self.shakeElapsed = float64(fadeIn)*activity
+	self.shakeRadius = 0
 }
 
 func (self *controller) cameraEndShake(fadeOut TicksDuration) {
 	if self.inDraw { panic("can't end shake during draw stage") }
 	activity := self.getShakeActivity()
-	self.shakeDuration = self.shakeElapsed - self.shakeFadeIn
+	self.shakeDuration = TicksDuration(max(self.shakeElapsed - float64(self.shakeFadeIn), 0.0))
 	self.shakeFadeOut  = fadeOut
-	self.shakeElapsed  = self.shakeFadeIn + self.shakeDuration
-	self.shakeElapsed += TicksDuration(float64(fadeOut)*(1.0 - activity))
+	self.shakeElapsed  = float64(self.shakeFadeIn) + float64(self.shakeDuration)
+	self.shakeElapsed += float64(fadeOut)*(1.0 - activity)
 }
 
 func (self *controller) cameraTriggerShake(fadeIn, duration, fadeOut TicksDuration) {
@@ -207,24 +514,168 @@ func (self *controller) cameraTriggerShake(fadeIn, duration, fadeOut TicksDurati
 	self.shakeFadeOut  = fadeOut // TODO: maybe triggered shakes shouldn't stop pre-existing continuous shakes?
 }
 
+func (self *controller) cameraTriggerShakeAt(x, y, radius float64, fadeIn, duration, fadeOut TicksDuration) {
+	if self.inDraw { panic("can't trigger shake during draw stage") }
+	if radius <= 0 { panic("shake radius must be strictly positive") }
+	self.cameraTriggerShake(fadeIn, duration, fadeOut)
+	self.shakeSourceX, self.shakeSourceY = x, y
+	self.shakeRadius = radius
+}
+
 func (self *controller) cameraIsShaking() bool {
 	if self.shakeElapsed == 0 {
 		return self.shakeFadeIn > 0 || self.shakeDuration > 0
 	} else {
-		if self.shakeElapsed < self.shakeDuration { return true }
-		return self.shakeElapsed < (self.shakeFadeIn + self.shakeDuration + self.shakeFadeOut)
+		if self.shakeElapsed < float64(self.shakeDuration) { return true }
+		return self.shakeElapsed < float64(self.shakeFadeIn) + float64(self.shakeDuration) + float64(self.shakeFadeOut)
 	}
 }
 
+// Attenuation factor for positional shakes, based on the
+// distance between the camera center and the shake source.
+func (self *controller) getShakeFalloff() float64 {
+	dist := math.Hypot(self.trackerCurrentX - self.shakeSourceX, self.trackerCurrentY - self.shakeSourceY)
+	return 1.0 - internal.CubicSmoothstepInterp(0.0, 1.0, dist/self.shakeRadius)
+}
+
 func (self *controller) getShakeActivity() float64 {
 	if self.shakeElapsed == 0 { return 0 }
-	if self.shakeElapsed < self.shakeFadeIn {
-		return float64(self.shakeElapsed)/float64(self.shakeFadeIn)
+	if self.shakeElapsed < float64(self.shakeFadeIn) {
+		return self.shakeElapsed/float64(self.shakeFadeIn)
 	} else {
-		elapsed := self.shakeElapsed - self.shakeFadeIn
-		if elapsed <= self.shakeDuration { return 1.0 } // shake in progress
-		elapsed -= self.shakeDuration
-		if elapsed >= self.shakeFadeOut { return 0.0 }
-		return 1.0 - float64(elapsed)/float64(self.shakeFadeOut)
+		elapsed := self.shakeElapsed - float64(self.shakeFadeIn)
+		if elapsed <= float64(self.shakeDuration) { return 1.0 } // shake in progress
+		elapsed -= float64(self.shakeDuration)
+		if elapsed >= float64(self.shakeFadeOut) { return 0.0 }
+		return 1.0 - elapsed/float64(self.shakeFadeOut)
+	}
+}
+
+// ---- kicks ----
+
+const kickDefaultDamping = 0.5
+const kickDefaultPower = 20.0
+
+func (self *controller) cameraKick(dx, dy float64) {
+	if self.inDraw { panic("can't kick camera during draw stage") }
+	if !self.kickSpring.IsInitialized() {
+		self.kickSpring.SetParameters(kickDefaultDamping, kickDefaultPower)
+	}
+	attenuation := 1.0 - self.motionReduction
+	self.kickSpeedX += self.kickSpring.SpeedForPeak(dx*attenuation)
+	self.kickSpeedY += self.kickSpring.SpeedForPeak(dy*attenuation)
+}
+
+func (self *controller) cameraSetKickParameters(damping, power float64) {
+	if self.inDraw { panic("can't set kick parameters during draw stage") }
+	if damping < 0.0 || damping > 1.0 {
+		panic("damping must be in [0, 1] range")
+	}
+	if power <= 0.0 {
+		panic("power must be strictly positive")
+	}
+	self.kickSpring.SetParameters(damping, power)
+}
+
+func (self *controller) cameraIsKicking() bool {
+	return self.kickOffsetX != 0.0 || self.kickOffsetY != 0.0 || self.kickSpeedX != 0.0 || self.kickSpeedY != 0.0
+}
+
+func (self *controller) updateKick() {
+	if !self.cameraIsKicking() { return }
+
+	prevOffsetX, prevOffsetY := self.kickOffsetX, self.kickOffsetY
+	self.kickOffsetX, self.kickSpeedX = self.kickSpring.Update(self.kickOffsetX, 0.0, self.kickSpeedX)
+	self.kickOffsetY, self.kickSpeedY = self.kickSpring.Update(self.kickOffsetY, 0.0, self.kickSpeedY)
+
+	// stabilization, don't keep oscillating on sub-pixel amounts forever
+	if internal.Abs(self.kickOffsetX) < 0.01 && internal.Abs(self.kickSpeedX) < 0.5 &&
+	   internal.Abs(self.kickOffsetY) < 0.01 && internal.Abs(self.kickSpeedY) < 0.5 {
+		self.kickOffsetX, self.kickOffsetY = 0.0, 0.0
+		self.kickSpeedX , self.kickSpeedY  = 0.0, 0.0
+	}
+
+	if self.redrawManaged && (prevOffsetX != self.kickOffsetX || prevOffsetY != self.kickOffsetY) {
+		self.needsRedraw = true
+	}
+}
+
+// ---- offsets ----
+
+func (self *controller) cameraSetOffset(dx, dy float64, transition TicksDuration) {
+	if self.inDraw { panic("can't set camera offset during draw stage") }
+	if math.IsNaN(dx) || math.IsNaN(dy) { panic("camera offset can't be NaN") }
+	self.offsetTargetX, self.offsetTargetY = dx, dy
+	self.offsetFromX, self.offsetFromY = self.offsetX, self.offsetY
+	self.offsetElapsed = 0
+	self.offsetDuration = transition
+	if transition == 0 {
+		if self.redrawManaged && (dx != self.offsetX || dy != self.offsetY) {
+			self.needsRedraw = true
+		}
+		self.offsetX, self.offsetY = dx, dy
+		self.updateCameraArea()
+	}
+}
+
+func (self *controller) cameraGetOffset() (float64, float64) {
+	return self.offsetTargetX, self.offsetTargetY
+}
+
+func (self *controller) updateOffset() {
+	if self.offsetElapsed >= float64(self.offsetDuration) { return }
+
+	prevOffsetX, prevOffsetY := self.offsetX, self.offsetY
+	self.offsetElapsed += self.tickAdvance
+	if self.offsetElapsed >= float64(self.offsetDuration) {
+		self.offsetX, self.offsetY = self.offsetTargetX, self.offsetTargetY
+	} else {
+		t := self.offsetElapsed/float64(self.offsetDuration)
+		self.offsetX = internal.QuadInOutInterp(self.offsetFromX, self.offsetTargetX, t)
+		self.offsetY = internal.QuadInOutInterp(self.offsetFromY, self.offsetTargetY, t)
+	}
+
+	if self.redrawManaged && (prevOffsetX != self.offsetX || prevOffsetY != self.offsetY) {
+		self.needsRedraw = true
+	}
+}
+
+// ---- motion reduction ----
+
+func (self *controller) cameraSetMotionReduction(reduction float64) {
+	if self.inDraw { panic("can't set motion reduction during draw stage") }
+	if reduction < 0.0 || reduction > 1.0 {
+		panic("motion reduction must be in [0, 1] range")
+	}
+	self.motionReduction = reduction
+}
+
+func (self *controller) cameraGetMotionReduction() float64 {
+	return self.motionReduction
+}
+
+// Tracking overshoot dampening only applies to spring based trackers.
+// Other trackers, like tracker.Rooms or tracker.Rail, can move away
+// from the notified target by design. Nil trackers are accepted
+// for convenience when checking transitions.
+func (self *controller) isTrackerSpringBased(camTracker tracker.Tracker) bool {
+	switch camTracker.(type) {
+	case nil, *tracker.Spring, *tracker.SpringTailer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Scales down the parts of a change that would overshoot the
+// target or move away from it, based on the motion reduction.
+func (self *controller) dampenOvershoot(current, target, change float64) float64 {
+	if self.motionReduction == 0.0 || change == 0.0 { return change }
+	attenuation := 1.0 - self.motionReduction
+	distance := target - current
+	if distance == 0.0 || (distance > 0) != (change > 0) {
+		return change*attenuation // moving away from target
 	}
+	if internal.Abs(change) <= internal.Abs(distance) { return change }
+	return distance + (change - distance)*attenuation
 }
@@ -0,0 +1,115 @@
+package mipix
+
+import "math"
+
+import "github.com/tinne26/mipix/internal"
+
+func (self *controller) cameraSnapshot() CameraState {
+	return CameraState{
+		TargetX: self.trackerTargetX,
+		TargetY: self.trackerTargetY,
+		CurrentX: self.trackerCurrentX,
+		CurrentY: self.trackerCurrentY,
+		SpeedX: self.trackerPrevSpeedX,
+		SpeedY: self.trackerPrevSpeedY,
+
+		ZoomCurrent: self.zoomCurrent,
+		ZoomTarget: self.zoomTarget,
+
+		ShakeElapsed: self.shakeElapsed,
+		ShakeFadeIn: self.shakeFadeIn,
+		ShakeDuration: self.shakeDuration,
+		ShakeFadeOut: self.shakeFadeOut,
+		ShakeOffsetX: self.shakeOffsetX,
+		ShakeOffsetY: self.shakeOffsetY,
+		ShakeZoom: self.shakeZoom,
+		ShakeSourceX: self.shakeSourceX,
+		ShakeSourceY: self.shakeSourceY,
+		ShakeRadius: self.shakeRadius,
+
+		KickOffsetX: self.kickOffsetX,
+		KickOffsetY: self.kickOffsetY,
+		KickSpeedX: self.kickSpeedX,
+		KickSpeedY: self.kickSpeedY,
+
+		OffsetX: self.offsetX,
+		OffsetY: self.offsetY,
+		OffsetFromX: self.offsetFromX,
+		OffsetFromY: self.offsetFromY,
+		OffsetTargetX: self.offsetTargetX,
+		OffsetTargetY: self.offsetTargetY,
+		OffsetElapsed: self.offsetElapsed,
+		OffsetDuration: self.offsetDuration,
+
+		FreezeLeft: self.freezeLeft,
+
+		Tick: self.currentTick,
+		Flushed: self.lastFlushCoordinatesTick == self.currentTick,
+	}
+}
+
+func (self *controller) cameraRestore(state CameraState) {
+	if self.inDraw { panic("can't restore camera state during draw stage") }
+	if math.IsNaN(state.ZoomCurrent) || math.IsNaN(state.ZoomTarget) { panic("zoom can't be NaN") }
+	if state.ZoomCurrent <= 0 || state.ZoomTarget <= 0 { panic("zoom must be strictly positive") }
+	if state.ShakeRadius < 0 { panic("shake radius can't be negative") }
+
+	// tracking
+	self.trackerTargetX, self.trackerTargetY = state.TargetX, state.TargetY
+	self.trackerCurrentX, self.trackerCurrentY = state.CurrentX, state.CurrentY
+	self.trackerPrevSpeedX, self.trackerPrevSpeedY = state.SpeedX, state.SpeedY
+	self.trackerBlendDuration = 0
+	self.sequence = nil
+	self.teleportPending = false
+
+	// zoom
+	self.zoomCurrent = internal.Clamp(state.ZoomCurrent, self.zoomMin, self.zoomMax)
+	internal.CurrentZoom = self.zoomCurrent
+	self.zoomerTransitionFrom = nil
+	self.cameraGetInternalZoomer().Reset()
+
+	// zones (re-evaluated without blending, like on coordinate
+	// resets; zone zoom overrides don't replace the restored target)
+	self.resetZoneTarget(state.TargetX, state.TargetY)
+	self.zoomTarget = internal.Clamp(state.ZoomTarget, self.zoomMin, self.zoomMax)
+	self.zoomAnchored = false
+	self.trackerTransitionFrom = nil
+	self.resetTrackers()
+
+	// shake
+	self.shakeElapsed = state.ShakeElapsed
+	self.shakeFadeIn = state.ShakeFadeIn
+	self.shakeDuration = state.ShakeDuration
+	self.shakeFadeOut = state.ShakeFadeOut
+	self.shakeOffsetX, self.shakeOffsetY = state.ShakeOffsetX, state.ShakeOffsetY
+	self.shakeZoom = state.ShakeZoom
+	self.shakeSourceX, self.shakeSourceY = state.ShakeSourceX, state.ShakeSourceY
+	self.shakeRadius = state.ShakeRadius
+	if self.cameraIsShaking() { self.shakeWasActive = true }
+
+	// kicks
+	self.kickOffsetX, self.kickOffsetY = state.KickOffsetX, state.KickOffsetY
+	self.kickSpeedX , self.kickSpeedY  = state.KickSpeedX , state.KickSpeedY
+
+	// offsets
+	self.offsetX, self.offsetY = state.OffsetX, state.OffsetY
+	self.offsetFromX, self.offsetFromY = state.OffsetFromX, state.OffsetFromY
+	self.offsetTargetX, self.offsetTargetY = state.OffsetTargetX, state.OffsetTargetY
+	self.offsetElapsed, self.offsetDuration = state.OffsetElapsed, state.OffsetDuration
+
+	// time
+	self.freezeLeft = state.FreezeLeft
+
+	if self.redrawManaged { self.needsRedraw = true }
+	self.updateCameraArea()
+}
+
+func (self *controller) cameraRestoreTick(state CameraState) {
+	if self.inDraw { panic("can't restore tick during draw stage") }
+	self.currentTick = state.Tick
+	if state.Flushed {
+		self.lastFlushCoordinatesTick = state.Tick
+	} else {
+		self.lastFlushCoordinatesTick = 0xFFFF_FFFF_FFFF_FFFF
+	}
+}
@@ -0,0 +1,45 @@
+package mipix
+
+import "math"
+
+func (self *controller) cameraSetPaused(paused bool) {
+	if self.inDraw { panic("can't pause camera during draw stage") }
+	self.cameraPaused = paused
+}
+
+func (self *controller) cameraIsPaused() bool {
+	return self.cameraPaused
+}
+
+func (self *controller) cameraSetTimeScale(scale float64) {
+	if self.inDraw { panic("can't set camera time scale during draw stage") }
+	if math.IsNaN(scale) || scale <= 0.0 { panic("time scale must be strictly positive") }
+	if scale > 16.0 { panic("time scale can't exceed 16.0") }
+	self.timeScale = scale
+}
+
+func (self *controller) cameraGetTimeScale() float64 {
+	return self.timeScale
+}
+
+func (self *controller) cameraFreeze(duration TicksDuration) {
+	if self.inDraw { panic("can't freeze camera during draw stage") }
+	self.freezeLeft = max(self.freezeLeft, duration)
+}
+
+func (self *controller) cameraIsFrozen() bool {
+	return self.freezeLeft > 0
+}
+
+// Computes the camera ticks to advance for the current flush,
+// which can be fractional, and stores them in tickAdvance. Returns false if the camera
+// shouldn't be updated at all due to pauses or freezes.
+func (self *controller) advanceCameraTime() bool {
+	if self.cameraPaused { return false }
+	if self.freezeLeft > 0 {
+		self.freezeLeft -= min(self.freezeLeft, TicksDuration(self.tickRate))
+		return false
+	}
+	self.tickAdvance = float64(self.tickRate)*self.timeScale
+	return true
+}
@@ -12,7 +12,8 @@ func (self *controller) convertToRelativeCoords(x, y int) (float64, float64) {
 func (self *controller) convertToLogicalCoords(x, y int) (float64, float64) {
 	rx, ry := self.convertToRelativeCoords(x, y)
 	minX, minY, _, _ := self.cameraAreaF64()
-	return minX + rx*float64(self.logicalWidth)/self.zoomCurrent, minY + ry*float64(self.logicalHeight)/self.zoomCurrent
+	zoom := self.cameraEffectiveZoom()
+	return minX + rx*float64(self.logicalWidth)/zoom, minY + ry*float64(self.logicalHeight)/zoom
 }
 
 func (self *controller) hackyGetMargins() (float64, float64) {
@@ -12,10 +12,15 @@ import "github.com/tinne26/mipix/shaker"
 
 var pkgController controller
 func init() {
+	pkgController.zoomMin, pkgController.zoomMax = zoomHardMin, zoomHardMax
 	pkgController.cameraZoomReset(1.0)
 	pkgController.tickSetRate(1)
 	pkgController.lastFlushCoordinatesTick = 0xFFFF_FFFF_FFFF_FFFF
 	pkgController.needsRedraw = true
+	pkgController.zoneActive = -1
+	pkgController.zoomSettled = true
+	pkgController.targetReached = true
+	pkgController.timeScale = 1.0
 }
 
 type controller struct {
@@ -42,34 +47,115 @@ type controller struct {
 	// camera
 	lastFlushCoordinatesTick uint64
 	cameraArea image.Rectangle
+	motionReduction float64
+	pixelSnapping PixelSnapping
 	
 	// tracking
 	tracker tracker.Tracker
+	trackerTransitionFrom tracker.Tracker
+	trackerTransitionElapsed float64 // in ticks
+	trackerTransitionDuration TicksDuration
 	trackerCurrentX float64
 	trackerCurrentY float64
 	trackerTargetX float64
 	trackerTargetY float64
 	trackerPrevSpeedX float64
 	trackerPrevSpeedY float64
+	teleportThreshold float64 // in screens, zero if disabled
+	teleportPending bool // set when teleporting during sequences
+	trackerBlendFromX float64
+	trackerBlendFromY float64
+	trackerBlendElapsed float64 // in ticks
+	trackerBlendDuration TicksDuration
+	groupZoomMin float64
+	groupZoomMax float64 // zero if automatic group zoom is disabled
 
 	// zoom
 	zoomer zoomer.Zoomer
+	zoomerTransitionFrom zoomer.Zoomer
+	zoomerTransitionElapsed float64 // in ticks
+	zoomerTransitionDuration TicksDuration
 	zoomCurrent float64
 	zoomTarget float64
+	zoomAnchored bool
+	zoomAnchorX float64
+	zoomAnchorY float64
+	zoomMin float64
+	zoomMax float64
+	zoomStops []float64
 
 	// shake
 	shaker shaker.Shaker
-	shakeElapsed TicksDuration
+	shakeElapsed float64 // in ticks
 	shakeFadeIn TicksDuration
 	shakeDuration TicksDuration
 	shakeFadeOut TicksDuration
 	shakeOffsetX float64
 	shakeOffsetY float64
+	shakeZoom float64
+	shakeSourceX float64
+	shakeSourceY float64
+	shakeRadius float64 // zero if the shake is not positional
+
+	// kicks
+	kickSpring internal.Spring
+	kickOffsetX float64
+	kickOffsetY float64
+	kickSpeedX float64
+	kickSpeedY float64
+
+	// offsets
+	offsetX float64
+	offsetY float64
+	offsetFromX float64
+	offsetFromY float64
+	offsetTargetX float64
+	offsetTargetY float64
+	offsetElapsed float64 // in ticks
+	offsetDuration TicksDuration
+
+	// sequences
+	sequence *Sequence
+	sequenceStep int
+	sequenceElapsed float64 // in ticks
+	sequenceFromX float64
+	sequenceFromY float64
+	sequencePrevZoomTarget float64
+
+	// zones
+	zones []Zone
+	zoneActive int // -1 if none
+	zonesChanged bool
+	zoneTracker tracker.Tracker
+	zoneBlend TicksDuration
+	zoneBlendFromX float64
+	zoneBlendFromY float64
+	zoneBlendElapsed float64 // in ticks
+	zoneBlendDuration TicksDuration
+	zoneLastX float64
+	zoneLastY float64
+	zoneZoomOverride bool
+	zonePrevZoomTarget float64
+
+	// events
+	onZoomSettled func()
+	onTargetReached func()
+	onShakeEnd func()
+	targetReachedEpsilon float64
+	zoomSettled bool
+	targetReached bool
+	wasShaking bool
 
 	// ticks
 	currentTick uint64
 	tickRate uint64
 
+	// camera time
+	cameraPaused bool
+	timeScale float64
+	tickAdvance float64 // camera ticks for the current flush, scaled
+	freezeLeft TicksDuration
+
 	// shaders
 	shaderOpts ebiten.DrawTrianglesShaderOptions
 	shaderVertices []ebiten.Vertex
@@ -335,8 +421,9 @@ func (self *controller) internalHiResDraw(target, source *ebiten.Image, x, y flo
 	targetBounds := target.Bounds()
 	targetMinX, targetMinY := float64(targetBounds.Min.X), float64(targetBounds.Min.Y)
 	targetWidth, targetHeight := float64(targetBounds.Dx()), float64(targetBounds.Dy())
-	xFactor := self.zoomCurrent*targetWidth/float64(self.logicalWidth)
-	yFactor := self.zoomCurrent*targetHeight/float64(self.logicalHeight)
+	zoom := self.cameraEffectiveZoom()
+	xFactor := zoom*targetWidth/float64(self.logicalWidth)
+	yFactor := zoom*targetHeight/float64(self.logicalHeight)
 	srcProjMinX := (x - camMinX)*xFactor
 	srcProjMinY := (y - camMinY)*yFactor
 	srcProjMaxX := srcProjMinX + sourceWidth*xFactor
@@ -0,0 +1,81 @@
+package mipix
+
+import "github.com/tinne26/mipix/internal"
+import "github.com/tinne26/mipix/zoomer"
+import "github.com/tinne26/mipix/tracker"
+
+func (self *controller) cameraOnZoomSettled(callback func()) {
+	if self.inDraw { panic("can't set camera callbacks during draw stage") }
+	self.onZoomSettled = callback
+}
+
+func (self *controller) cameraOnTargetReached(epsilon float64, callback func()) {
+	if self.inDraw { panic("can't set camera callbacks during draw stage") }
+	if epsilon < 0 { panic("epsilon can't be negative") }
+	self.targetReachedEpsilon = epsilon
+	self.onTargetReached = callback
+}
+
+func (self *controller) cameraOnShakeEnd(callback func()) {
+	if self.inDraw { panic("can't set camera callbacks during draw stage") }
+	self.onShakeEnd = callback
+}
+
+// Evaluates the camera events and invokes the relevant callbacks
+// on state changes. Called at the end of cameraFlushCoordinates().
+func (self *controller) notifyEvents() {
+	// update states first, so callbacks can safely
+	// modify the camera state and query it
+	zoomSettled := self.isZoomSettled()
+	zoomHasSettled := zoomSettled && !self.zoomSettled
+	self.zoomSettled = zoomSettled
+
+	targetHasBeenReached := false
+	if self.sequence == nil { // not evaluated while sequences are playing
+		targetReached := self.isTargetReached()
+		targetHasBeenReached = targetReached && !self.targetReached
+		self.targetReached = targetReached
+	}
+
+	isShaking := self.cameraIsShaking()
+	shakeHasEnded := !isShaking && self.wasShaking
+	self.wasShaking = isShaking
+
+	// invoke callbacks
+	if zoomHasSettled && self.onZoomSettled != nil {
+		self.onZoomSettled()
+	}
+	if targetHasBeenReached && self.onTargetReached != nil {
+		self.onTargetReached()
+	}
+	if shakeHasEnded && self.onShakeEnd != nil {
+		self.onShakeEnd()
+	}
+}
+
+func (self *controller) isZoomSettled() bool {
+	if self.zoomCurrent != self.zoomTarget || self.zoomerTransitionFrom != nil {
+		return false
+	}
+	if settler, ok := self.cameraGetInternalZoomer().(zoomer.Settler); ok {
+		return settler.IsSettled()
+	}
+	return true
+}
+
+func (self *controller) isTargetReached() bool {
+	if self.trackerTransitionFrom != nil || self.trackerBlendElapsed < float64(self.trackerBlendDuration) {
+		return false
+	}
+
+	// trackers that know where they are going have the last word,
+	// as their destination doesn't need to match the target
+	if settler, ok := self.cameraGetInternalTracker().(tracker.Settler); ok {
+		return settler.IsSettled()
+	}
+
+	// zoneLastX/Y are the final coordinates passed to the tracker,
+	// after sequence blends and zone constraints have been applied
+	if internal.Abs(self.zoneLastX - self.trackerCurrentX) > self.targetReachedEpsilon { return false }
+	return internal.Abs(self.zoneLastY - self.trackerCurrentY) <= self.targetReachedEpsilon
+}
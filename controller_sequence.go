@@ -0,0 +1,119 @@
+package mipix
+
+import "github.com/tinne26/mipix/internal"
+
+func (self *controller) cameraPlaySequence(sequence *Sequence) {
+	if self.inDraw { panic("can't play sequence during draw stage") }
+	if sequence == nil { panic("can't play nil sequence") }
+	if self.sequence == nil {
+		self.sequencePrevZoomTarget = self.zoomTarget
+	}
+	self.sequence = sequence
+	self.sequenceStep = 0
+	self.sequenceElapsed = 0
+	self.sequenceFromX, self.sequenceFromY = self.trackerCurrentX, self.trackerCurrentY
+	self.trackerBlendDuration = 0
+}
+
+func (self *controller) cameraStopSequence() {
+	if self.inDraw { panic("can't stop sequence during draw stage") }
+	if self.sequence == nil { return }
+	self.endSequence()
+}
+
+func (self *controller) cameraIsPlayingSequence() bool {
+	return self.sequence != nil
+}
+
+func (self *controller) updateSequence() {
+	prevX, prevY := self.trackerCurrentX, self.trackerCurrentY
+	sequence := self.sequence
+	advance := self.tickAdvance
+	for self.sequenceStep < len(sequence.steps) {
+		step := &sequence.steps[self.sequenceStep]
+		switch step.kind {
+		case sequenceStepZoom:
+			self.cameraZoom(step.x)
+		case sequenceStepShake:
+			self.cameraTriggerShake(step.fadeIn, step.duration, step.fadeOut)
+		case sequenceStepCall:
+			stepIndex := self.sequenceStep
+			step.callback()
+			if self.sequence != sequence || self.sequenceStep != stepIndex {
+				return // sequence stopped or replaced
+			}
+		case sequenceStepPan, sequenceStepHold:
+			// ticks left over when the step ends carry into the next one
+			elapsed := self.sequenceElapsed + advance
+			advance = max(elapsed - float64(step.duration), 0)
+			self.sequenceElapsed = min(elapsed, float64(step.duration))
+			if step.kind == sequenceStepPan {
+				t := 1.0
+				if step.duration > 0 {
+					t = self.sequenceElapsed/float64(step.duration)
+				}
+				self.trackerCurrentX, self.trackerCurrentY = self.sequencePanPosition(step, t)
+			}
+		default:
+			panic("invalid sequence step")
+		}
+		if step.isTimed() && self.sequenceElapsed < float64(step.duration) { break }
+
+		// move to next step
+		self.sequenceStep += 1
+		self.sequenceElapsed = 0
+		self.sequenceFromX, self.sequenceFromY = self.trackerCurrentX, self.trackerCurrentY
+	}
+
+	// update speeds and redraw
+	changeX, changeY := self.trackerCurrentX - prevX, self.trackerCurrentY - prevY
+	updateDelta := internal.GetUpdateDelta()
+	self.trackerPrevSpeedX = changeX/updateDelta
+	self.trackerPrevSpeedY = changeY/updateDelta
+	if self.redrawManaged && (changeX != 0 || changeY != 0) {
+		self.needsRedraw = true
+	}
+
+	if self.sequenceStep >= len(sequence.steps) {
+		self.endSequence()
+	}
+}
+
+func (self *controller) sequencePanPosition(step *sequenceStep, t float64) (float64, float64) {
+	easing := self.sequence.easing
+	if easing == nil { easing = internal.QuadInOut }
+	t = easing(t)
+	fromX, fromY := self.sequenceFromX, self.sequenceFromY
+	switch step.controlPoints {
+	case 0:
+		return internal.LinearInterp(fromX, step.x, t), internal.LinearInterp(fromY, step.y, t)
+	case 1:
+		omt := 1.0 - t
+		a, b, c := omt*omt, 2.0*omt*t, t*t
+		return a*fromX + b*step.ctrl1X + c*step.x, a*fromY + b*step.ctrl1Y + c*step.y
+	case 2:
+		omt := 1.0 - t
+		a, b, c, d := omt*omt*omt, 3.0*omt*omt*t, 3.0*omt*t*t, t*t*t
+		x := a*fromX + b*step.ctrl1X + c*step.ctrl2X + d*step.x
+		y := a*fromY + b*step.ctrl1Y + c*step.ctrl2Y + d*step.y
+		return x, y
+	default:
+		panic("invalid sequence pan")
+	}
+}
+
+func (self *controller) endSequence() {
+	returnBlend := self.sequence.returnBlend
+	self.sequence = nil
+	self.cameraZoom(self.sequencePrevZoomTarget)
+	if self.teleportPending { // teleport notified during the sequence
+		self.cameraResetCoordinates(self.trackerTargetX, self.trackerTargetY)
+		return
+	}
+	self.resetTrackers()
+	if returnBlend > 0 {
+		self.trackerBlendFromX, self.trackerBlendFromY = self.trackerCurrentX, self.trackerCurrentY
+		self.trackerBlendElapsed = 0
+		self.trackerBlendDuration = returnBlend
+	}
+}
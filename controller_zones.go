@@ -0,0 +1,116 @@
+package mipix
+
+import "github.com/tinne26/mipix/internal"
+import "github.com/tinne26/mipix/tracker"
+
+func (self *controller) cameraSetZones(zones []Zone) {
+	if self.inDraw { panic("can't set zones during draw stage") }
+	for i := range zones {
+		if zones[i].Zoom < 0 { panic("zone zoom can't be negative") }
+	}
+	self.zones = append(self.zones[ : 0], zones...)
+	self.zonesChanged = true
+}
+
+func (self *controller) cameraGetActiveZone() int {
+	return self.zoneActive
+}
+
+func (self *controller) updateZones() {
+	index := self.findZone(self.trackerTargetX, self.trackerTargetY)
+	if index != self.zoneActive || self.zonesChanged {
+		self.switchZone(index, true)
+	}
+}
+
+// Returns the index of the first zone containing the given
+// point, or -1 if none.
+func (self *controller) findZone(x, y float64) int {
+	for i := range self.zones {
+		area := self.zones[i].Area
+		if x >= float64(area.Min.X) && x < float64(area.Max.X) && y >= float64(area.Min.Y) && y < float64(area.Max.Y) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (self *controller) switchZone(index int, blend bool) {
+	self.zonesChanged = false
+	self.zoneActive = index
+	var zone *Zone
+	if index >= 0 { zone = &self.zones[index] }
+
+	// the blend duration is given by the zone being entered,
+	// or by the previous zone if we are leaving all zones
+	if zone != nil { self.zoneBlend = zone.Blend }
+	duration := self.zoneBlend
+	if !blend { duration = 0 }
+	self.zoneBlendFromX, self.zoneBlendFromY = self.zoneLastX, self.zoneLastY
+	self.zoneBlendElapsed, self.zoneBlendDuration = 0, duration
+
+	// zoom override
+	if zone != nil && zone.Zoom != 0 {
+		if !self.zoneZoomOverride {
+			self.zonePrevZoomTarget = self.zoomTarget
+			self.zoneZoomOverride = true
+		}
+		self.cameraZoom(zone.Zoom)
+	} else if self.zoneZoomOverride {
+		self.zoneZoomOverride = false
+		self.cameraZoom(self.zonePrevZoomTarget)
+	}
+
+	// tracker override
+	var zoneTracker tracker.Tracker
+	if zone != nil { zoneTracker = zone.Tracker }
+	if zoneTracker != self.zoneTracker {
+		from := self.cameraGetInternalTracker()
+		self.zoneTracker = zoneTracker
+		self.trackerTransitionFrom = nil
+		if duration > 0 && from != self.cameraGetInternalTracker() {
+			self.trackerTransitionFrom = from
+			self.trackerTransitionElapsed = 0
+			self.trackerTransitionDuration = duration
+		}
+	}
+}
+
+// Applies the active zone constraints to the given target
+// coordinates and advances the zone blend, if any.
+func (self *controller) getZoneTarget(x, y float64) (float64, float64) {
+	if self.zoneActive >= 0 {
+		x, y = self.constrainToZone(&self.zones[self.zoneActive], x, y)
+	}
+	if self.zoneBlendElapsed < float64(self.zoneBlendDuration) {
+		self.zoneBlendElapsed += self.tickAdvance
+		t := internal.QuadInOut(min(self.zoneBlendElapsed/float64(self.zoneBlendDuration), 1.0))
+		x = internal.LinearInterp(self.zoneBlendFromX, x, t)
+		y = internal.LinearInterp(self.zoneBlendFromY, y, t)
+	}
+	self.zoneLastX, self.zoneLastY = x, y
+	return x, y
+}
+
+// Re-evaluates zones without blending and returns the
+// constrained coordinates. Used on coordinate resets.
+func (self *controller) resetZoneTarget(x, y float64) (float64, float64) {
+	index := self.findZone(x, y)
+	if index != self.zoneActive || self.zonesChanged {
+		self.switchZone(index, false)
+	}
+	self.zoneBlendDuration = 0
+	return self.getZoneTarget(x, y)
+}
+
+func (self *controller) constrainToZone(zone *Zone, x, y float64) (float64, float64) {
+	if zone.LockX { x = zone.X }
+	if zone.LockY { y = zone.Y }
+	if !zone.Bounds.Empty() {
+		halfWidth  := float64(self.logicalWidth )/(self.zoomCurrent*2.0)
+		halfHeight := float64(self.logicalHeight)/(self.zoomCurrent*2.0)
+		x = internal.ClampToSpan(x, float64(zone.Bounds.Min.X), float64(zone.Bounds.Max.X), halfWidth)
+		y = internal.ClampToSpan(y, float64(zone.Bounds.Min.Y), float64(zone.Bounds.Max.Y), halfHeight)
+	}
+	return x, y
+}
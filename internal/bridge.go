@@ -6,6 +6,7 @@ var BridgedLogicalWidth int
 var BridgedLogicalHeight int
 var CurrentZoom float64
 var CurrentTPU uint64 // ticks per update
+var CurrentTimeScale float64 = 1.0
 
 func GetCurrentZoom() float64 {
 	return CurrentZoom
@@ -19,6 +20,18 @@ func GetUPS() int {
 	return ebiten.TPS()
 }
 
+// Returns the duration of the current update in seconds,
+// with the camera time scale applied.
+func GetUpdateDelta() float64 {
+	return CurrentTimeScale/float64(ebiten.TPS())
+}
+
 func GetTPU() uint64 {
 	return CurrentTPU
 }
+
+// Returns the ticks advanced by the current update, with the
+// camera time scale applied. Can be fractional.
+func GetTickAdvance() float64 {
+	return float64(CurrentTPU)*CurrentTimeScale
+}
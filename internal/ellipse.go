@@ -1,13 +1,14 @@
 package internal
 
 import "math"
-import "math/rand"
+import "math/rand/v2"
 
-func RollPointWithinEllipse(width, height float64) (float64, float64) {
+// The rng can be nil to use the global source.
+func RollPointWithinEllipse(rng *rand.Rand, width, height float64) (float64, float64) {
 	// the tangent of angles approaching 90degs goes to infinite,
 	// so I'm limiting it to 89.99 degrees at most
 	const AsymptoteMargin = 0.02*math.Pi/180.0
-	angle := rand.Float64()*(math.Pi - AsymptoteMargin) - (math.Pi/2.0 - AsymptoteMargin/2)
+	angle := RandFloat64(rng)*(math.Pi - AsymptoteMargin) - (math.Pi/2.0 - AsymptoteMargin/2)
 	slope := math.Tan(angle)
 
 	// get half of the width and height
@@ -19,8 +20,8 @@ func RollPointWithinEllipse(width, height float64) (float64, float64) {
 	// if we solve the system, we get:
 	x := (height*width)/math.Sqrt(height*height + (slope*slope)*width*width)
 	y := slope*x
-	if rand.Float64() < 0.5 { x = -x }
-	x *= EaseOutQuad(rand.Float64())
-	y *= EaseOutQuad(rand.Float64())
+	if RandFloat64(rng) < 0.5 { x = -x }
+	x *= EaseOutQuad(RandFloat64(rng))
+	y *= EaseOutQuad(RandFloat64(rng))
 	return x, y
 }
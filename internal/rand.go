@@ -0,0 +1,22 @@
+package internal
+
+import "math/rand/v2"
+
+// Returns rng.Float64(), or the global rand.Float64() if rng is nil.
+func RandFloat64(rng *rand.Rand) float64 {
+	if rng == nil { return rand.Float64() }
+	return rng.Float64()
+}
+
+// Returns rng.Uint64(), or the global rand.Uint64() if rng is nil.
+func RandUint64(rng *rand.Rand) uint64 {
+	if rng == nil { return rand.Uint64() }
+	return rng.Uint64()
+}
+
+// Returns a new [rand.Rand] for the given source, or nil if
+// the source is nil.
+func NewRand(source rand.Source) *rand.Rand {
+	if source == nil { return nil }
+	return rand.New(source)
+}
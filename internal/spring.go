@@ -7,7 +7,7 @@ type Spring struct {
 	damping   float64 // drag / resistance [0...1.0]
 	frequency float64 // power / speed [0.001...Inf]
 
-	lastUPS int
+	lastDelta float64
 	tempAlpha  float64
 	tempExp    float64
 	tempCosExp float64
@@ -34,8 +34,8 @@ func (self *Spring) SetParameters(damping, frequency float64) {
 }
 
 func (self *Spring) recomputeExpensiveTerms() {
-	self.lastUPS = GetUPS()
-	delta := 1.0/float64(self.lastUPS)
+	self.lastDelta = GetUpdateDelta()
+	delta := self.lastDelta
 	if self.damping >= 0.999 {
 		self.tempExp   = math.Exp(-self.frequency*delta)
 	} else {
@@ -51,13 +51,13 @@ func (self *Spring) recomputeExpensiveTerms() {
 func (self *Spring) Update(current, target, speed float64) (float64, float64) {
 	if !self.initialized { panic("must Spring.SetParameters() before using") }
 	
-	if GetUPS() != self.lastUPS {
+	if GetUpdateDelta() != self.lastDelta {
 		self.recomputeExpensiveTerms()
 	}
 
 	var posPos, velVel, posVel, velPos float64
 	if self.damping >= 0.999 {
-		posVel = (1.0/float64(self.lastUPS))*self.tempExp
+		posVel = self.lastDelta*self.tempExp
 		expr := posVel*self.frequency
 		velPos = -self.frequency*expr
 		posPos = +expr + self.tempExp
@@ -76,3 +76,19 @@ func (self *Spring) Update(current, target, speed float64) (float64, float64) {
 	speed   = mirroredStart*velPos + speed*velVel
 	return current, speed
 }
+
+// Returns the initial speed that a spring at rest needs in
+// order to reach the given peak displacement before recoiling.
+func (self *Spring) SpeedForPeak(peak float64) float64 {
+	if !self.initialized { panic("must Spring.SetParameters() before using") }
+	if self.damping >= 0.999 {
+		// x(t) = v*t*e^(-f*t), peak at t = 1/f
+		return peak*self.frequency*math.E
+	}
+
+	// x(t) = (v/alpha)*e^(-f*d*t)*sin(alpha*t), peak at tan(alpha*t) = alpha/(f*d)
+	freqByDamp := self.frequency*self.damping
+	alpha := self.frequency*math.Sqrt(1.0 - self.damping*self.damping)
+	peakTime := math.Atan2(alpha, freqByDamp)/alpha
+	return peak*alpha/(math.Exp(-freqByDamp*peakTime)*math.Sin(alpha*peakTime))
+}
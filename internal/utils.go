@@ -17,6 +17,15 @@ func ClampTowardsZero[T float64 | float32 | int | int16 | int32 | int64](x, clam
 	return max(x, clampReference)
 }
 
+// Clamps the given camera center so the view stays within [min, max],
+// or centers it if the span is smaller than the view.
+func ClampToSpan(center, minCoord, maxCoord, halfView float64) float64 {
+	if maxCoord - minCoord <= halfView*2.0 {
+		return (minCoord + maxCoord)/2.0
+	}
+	return Clamp(center, minCoord + halfView, maxCoord - halfView)
+}
+
 func Abs[T float64 | float32 | int | int8 | int16 | int32 | int64](x T) T {
 	if x >= 0 { return x }
 	return -x
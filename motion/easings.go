@@ -0,0 +1,169 @@
+// This package exposes the easing functions and spring simulations
+// used internally by mipix, so you can animate UI and other elements
+// of your game consistently with the camera.
+//
+// Easing functions take a t value in [0, 1] and return the eased
+// progress. Values outside the [0, 1] range are clamped. Some easings
+// like back and elastic overshoot, so their results can temporarily
+// go outside the [0, 1] range.
+//
+// Springs are update-rate aware, like the camera trackers and
+// zoomers. See [ups-vs-tps] if you need more context.
+//
+// [ups-vs-tps]: https://github.com/tinne26/mipix/blob/main/docs/ups-vs-tps.md
+package motion
+
+import "math"
+
+import "github.com/tinne26/mipix/internal"
+
+// Linear interpolation between a and b. The t value is not
+// clamped, so it can also be used for extrapolation.
+func Lerp(a, b, t float64) float64 {
+	return internal.LinearInterp(a, b, t)
+}
+
+// Interpolates between a and b using the given easing function.
+func Interp(a, b, t float64, easing func(float64) float64) float64 {
+	return internal.LinearInterp(a, b, easing(t))
+}
+
+// --- basic ---
+
+func Linear(t float64) float64 { return internal.Clamp(t, 0, 1) }
+
+// Cubic smoothstep, very similar to [EaseInOutQuad]
+// but with slightly softer turns.
+func Smoothstep(t float64) float64 { return internal.CubicSmoothstepInterp(0, 1, t) }
+
+// --- quadratic ---
+
+func EaseInQuad(t float64) float64 { return internal.EaseInQuad(t) }
+func EaseOutQuad(t float64) float64 { return internal.EaseOutQuad(t) }
+func EaseInOutQuad(t float64) float64 { return internal.QuadInOut(t) }
+
+// --- cubic ---
+
+func EaseInCubic(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	return t*t*t
+}
+func EaseOutCubic(t float64) float64 { return internal.EaseOutCubic(t) }
+func EaseInOutCubic(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t < 0.5 { return 4*t*t*t }
+	omt := -2*t + 2
+	return 1 - omt*omt*omt/2
+}
+
+// --- sine ---
+
+func EaseInSine(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	return 1 - math.Cos(t*math.Pi/2)
+}
+func EaseOutSine(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	return math.Sin(t*math.Pi/2)
+}
+func EaseInOutSine(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	return -(math.Cos(math.Pi*t) - 1)/2
+}
+
+// --- exponential ---
+
+func EaseInExpo(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t == 0 { return 0 }
+	return math.Pow(2, 10*t - 10)
+}
+func EaseOutExpo(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t == 1 { return 1 }
+	return 1 - math.Pow(2, -10*t)
+}
+func EaseInOutExpo(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	switch {
+	case t == 0: return 0
+	case t == 1: return 1
+	case t < 0.5: return math.Pow(2, 20*t - 10)/2
+	default: return (2 - math.Pow(2, -20*t + 10))/2
+	}
+}
+
+// --- back (overshooting) ---
+
+const backC1 = 1.70158
+const backC2 = backC1*1.525
+const backC3 = backC1 + 1
+
+func EaseInBack(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	return backC3*t*t*t - backC1*t*t
+}
+func EaseOutBack(t float64) float64 {
+	t = internal.Clamp(t, 0, 1) - 1
+	return 1 + backC3*t*t*t + backC1*t*t
+}
+func EaseInOutBack(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t < 0.5 {
+		return (4*t*t*((backC2 + 1)*2*t - backC2))/2
+	}
+	t = 2*t - 2
+	return (t*t*((backC2 + 1)*t + backC2) + 2)/2
+}
+
+// --- elastic ---
+
+const elasticC4 = (2*math.Pi)/3
+const elasticC5 = (2*math.Pi)/4.5
+
+func EaseInElastic(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t == 0 || t == 1 { return t }
+	return -math.Pow(2, 10*t - 10)*math.Sin((t*10 - 10.75)*elasticC4)
+}
+func EaseOutElastic(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t == 0 || t == 1 { return t }
+	return math.Pow(2, -10*t)*math.Sin((t*10 - 0.75)*elasticC4) + 1
+}
+func EaseInOutElastic(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	switch {
+	case t == 0 || t == 1: return t
+	case t < 0.5: return -(math.Pow(2, 20*t - 10)*math.Sin((20*t - 11.125)*elasticC5))/2
+	default: return (math.Pow(2, -20*t + 10)*math.Sin((20*t - 11.125)*elasticC5))/2 + 1
+	}
+}
+
+// --- bounce ---
+
+func EaseInBounce(t float64) float64 {
+	return 1 - EaseOutBounce(1 - internal.Clamp(t, 0, 1))
+}
+func EaseOutBounce(t float64) float64 {
+	const N1, D1 = 7.5625, 2.75
+	t = internal.Clamp(t, 0, 1)
+	switch {
+	case t < 1/D1:
+		return N1*t*t
+	case t < 2/D1:
+		t -= 1.5/D1
+		return N1*t*t + 0.75
+	case t < 2.5/D1:
+		t -= 2.25/D1
+		return N1*t*t + 0.9375
+	default:
+		t -= 2.625/D1
+		return N1*t*t + 0.984375
+	}
+}
+func EaseInOutBounce(t float64) float64 {
+	t = internal.Clamp(t, 0, 1)
+	if t < 0.5 { return (1 - EaseOutBounce(1 - 2*t))/2 }
+	return (1 + EaseOutBounce(2*t - 1))/2
+}
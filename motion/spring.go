@@ -0,0 +1,183 @@
+package motion
+
+import "github.com/tinne26/mipix/internal"
+
+// A spring simulation for a single value. Springs are updated
+// once per update, and are update-rate aware: the results will
+// be perceptually the same regardless of the updates per second.
+//
+// Springs are great for UI elements that need to follow changing
+// targets smoothly, as retargeting mid-transition preserves the
+// current speed instead of restarting the animation.
+//
+// Usage example:
+//   var spring motion.Spring
+//   spring.Reset(0.0)
+//   spring.SetTarget(100.0)
+//   // ...and then on each update:
+//   x := spring.Update()
+type Spring struct {
+	spring internal.Spring
+	value float64
+	speed float64
+	target float64
+	epsilon float64
+}
+
+func (self *Spring) ensureInitialized() {
+	if !self.spring.IsInitialized() {
+		self.spring.SetParameters(1.0, 10.0)
+	}
+	if self.epsilon == 0.0 {
+		self.epsilon = 0.001
+	}
+}
+
+// Damping values must be in [0.0, 1.0] range, where 1.0 is a
+// critically damped spring that doesn't overshoot, and lower
+// values make the spring bouncier. Power must be strictly
+// positive, and determines how fast the spring moves.
+// Defaults are (1.0, 10.0).
+func (self *Spring) SetParameters(damping, power float64) {
+	if damping < 0.0 || damping > 1.0 {
+		panic("damping must be in [0, 1] range")
+	}
+	if power <= 0.0 {
+		panic("power must be strictly positive")
+	}
+	self.spring.SetParameters(damping, power)
+}
+
+// Sets the distance and speed below which the spring snaps to
+// its target and stops. Defaults to 0.001, which works well for
+// values in pixels. For normalized values, you might want to
+// use lower thresholds.
+func (self *Spring) SetEpsilon(epsilon float64) {
+	if epsilon <= 0.0 { panic("epsilon must be strictly positive") }
+	self.epsilon = epsilon
+}
+
+// Immediately sets both the value and the target, and
+// stops any motion.
+func (self *Spring) Reset(value float64) {
+	self.value, self.target, self.speed = value, value, 0.0
+}
+
+// Sets the target value for the spring.
+func (self *Spring) SetTarget(target float64) {
+	self.target = target
+}
+
+// Returns the target value for the spring.
+func (self *Spring) GetTarget() float64 {
+	return self.target
+}
+
+// Returns the current value without updating the spring.
+func (self *Spring) Value() float64 {
+	return self.value
+}
+
+// Returns the current speed, in units per second.
+func (self *Spring) Speed() float64 {
+	return self.speed
+}
+
+// Returns whether the spring has reached its target and stopped.
+func (self *Spring) IsSettled() bool {
+	return self.value == self.target && self.speed == 0.0
+}
+
+// Advances the simulation by one update and returns the new value.
+func (self *Spring) Update() float64 {
+	if self.IsSettled() { return self.value }
+	self.ensureInitialized()
+	self.value, self.speed = self.spring.Update(self.value, self.target, self.speed)
+	if internal.Abs(self.target - self.value) < self.epsilon && internal.Abs(self.speed) < self.epsilon {
+		self.value, self.speed = self.target, 0.0
+	}
+	return self.value
+}
+
+// Like [Spring], but for 2D values. Both axes share the
+// same spring parameters.
+type Spring2D struct {
+	spring internal.Spring
+	x, y float64
+	speedX, speedY float64
+	targetX, targetY float64
+	epsilon float64
+}
+
+func (self *Spring2D) ensureInitialized() {
+	if !self.spring.IsInitialized() {
+		self.spring.SetParameters(1.0, 10.0)
+	}
+	if self.epsilon == 0.0 {
+		self.epsilon = 0.001
+	}
+}
+
+// See [Spring.SetParameters]().
+func (self *Spring2D) SetParameters(damping, power float64) {
+	if damping < 0.0 || damping > 1.0 {
+		panic("damping must be in [0, 1] range")
+	}
+	if power <= 0.0 {
+		panic("power must be strictly positive")
+	}
+	self.spring.SetParameters(damping, power)
+}
+
+// See [Spring.SetEpsilon]().
+func (self *Spring2D) SetEpsilon(epsilon float64) {
+	if epsilon <= 0.0 { panic("epsilon must be strictly positive") }
+	self.epsilon = epsilon
+}
+
+// Immediately sets both the position and the target, and
+// stops any motion.
+func (self *Spring2D) Reset(x, y float64) {
+	self.x, self.y = x, y
+	self.targetX, self.targetY = x, y
+	self.speedX, self.speedY = 0.0, 0.0
+}
+
+// Sets the target position for the spring.
+func (self *Spring2D) SetTarget(x, y float64) {
+	self.targetX, self.targetY = x, y
+}
+
+// Returns the target position for the spring.
+func (self *Spring2D) GetTarget() (x, y float64) {
+	return self.targetX, self.targetY
+}
+
+// Returns the current position without updating the spring.
+func (self *Spring2D) Position() (x, y float64) {
+	return self.x, self.y
+}
+
+// Returns the current speeds, in units per second.
+func (self *Spring2D) Speed() (x, y float64) {
+	return self.speedX, self.speedY
+}
+
+// Returns whether the spring has reached its target and stopped.
+func (self *Spring2D) IsSettled() bool {
+	return self.x == self.targetX && self.y == self.targetY && self.speedX == 0.0 && self.speedY == 0.0
+}
+
+// Advances the simulation by one update and returns the new position.
+func (self *Spring2D) Update() (x, y float64) {
+	if self.IsSettled() { return self.x, self.y }
+	self.ensureInitialized()
+	self.x, self.speedX = self.spring.Update(self.x, self.targetX, self.speedX)
+	self.y, self.speedY = self.spring.Update(self.y, self.targetY, self.speedY)
+	if internal.Abs(self.targetX - self.x) < self.epsilon && internal.Abs(self.speedX) < self.epsilon &&
+	   internal.Abs(self.targetY - self.y) < self.epsilon && internal.Abs(self.speedY) < self.epsilon {
+		self.x, self.y = self.targetX, self.targetY
+		self.speedX, self.speedY = 0.0, 0.0
+	}
+	return self.x, self.y
+}
@@ -28,6 +28,7 @@ type Balanced struct {
 	travelTime float64
 	axisRatio float64
 	zoomCompensation float64
+	rng *rand.Rand
 	initialized bool
 }
 
@@ -40,7 +41,7 @@ func (self *Balanced) ensureInitialized() {
 	if self.travelTime == 0.0 {
 		self.travelTime = 0.05
 	}
-	self.rads = rand.Float64()*2.0*math.Pi
+	self.rads = internal.RandFloat64(self.rng)*2.0*math.Pi
 	self.rerollControlPoints()
 }
 
@@ -75,12 +76,18 @@ func (self *Balanced) SetTravelTime(travelTime float64) {
 	self.travelTime = travelTime
 }
 
+// See [Quake.SetRandSource]().
+func (self *Balanced) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements the [Shaker] interface.
 func (self *Balanced) GetShakeOffsets(level float64) (float64, float64) {
 	self.ensureInitialized()
 	if level == 0.0 {
 		self.elapsed = 0.0
-		self.rads = rand.Float64()*2.0*math.Pi
+		self.rads = internal.RandFloat64(self.rng)*2.0*math.Pi
 		self.rerollControlPoints()
 		return 0.0, 0.0
 	}
@@ -98,7 +105,7 @@ func (self *Balanced) GetShakeOffsets(level float64) (float64, float64) {
 	ix   , iy    := lerp(iox, ioy, ifx, ify, t)       // interpolated
 
 	// roll new point, slide previous
-	self.elapsed += 1.0/float64(internal.GetUPS())
+	self.elapsed += internal.GetUpdateDelta()
 	if self.elapsed >= self.travelTime {
 		self.rerollControlPoints()
 		for self.elapsed >= self.travelTime {
@@ -125,7 +132,7 @@ func (self *Balanced) GetShakeOffsets(level float64) (float64, float64) {
 }
 
 func (self *Balanced) rerollControlPoints() {
-	length := 0.8 + rand.Float64()*0.2
+	length := 0.8 + internal.RandFloat64(self.rng)*0.2
 	sin, cos := math.Sincos(self.rads)
 	self.cx1 = cos*length
 	self.cy1 = sin*length
@@ -133,7 +140,7 @@ func (self *Balanced) rerollControlPoints() {
 	// shift angle for the exit direction, which will
 	// also be used as the entry direction for the next
 	// point (with an 180 degree offset)
-	self.rads += math.Pi*rand.Float64()*0.3333 // yes, shift in a consistent direction
+	self.rads += math.Pi*internal.RandFloat64(self.rng)*0.3333 // yes, shift in a consistent direction
 	if self.rads >= 2.0*math.Pi { self.rads -= 2.0*math.Pi }
 
 	sin, cos = math.Sincos(self.rads)
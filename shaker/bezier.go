@@ -1,5 +1,7 @@
 package shaker
 
+import "math/rand/v2"
+
 import "github.com/tinne26/mipix/internal"
 
 var _ Shaker = (*Bezier)(nil)
@@ -21,6 +23,7 @@ type Bezier struct {
 	travelTime float64
 	axisRatio float64
 	zoomCompensation float64
+	rng *rand.Rand
 	initialized bool
 }
 
@@ -55,6 +58,12 @@ func (self *Bezier) SetTravelTime(travelTime float64) {
 	self.travelTime = travelTime
 }
 
+// See [Quake.SetRandSource]().
+func (self *Bezier) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements the [Shaker] interface.
 func (self *Bezier) GetShakeOffsets(level float64) (float64, float64) {
 	self.ensureInitialized()
@@ -74,7 +83,7 @@ func (self *Bezier) GetShakeOffsets(level float64) (float64, float64) {
 	ix , iy  := lerp(ocx, ocy, cfx, cfy, t) // interpolated point
 
 	// roll new point, slide previous
-	self.elapsed += 1.0/float64(internal.GetUPS())
+	self.elapsed += internal.GetUpdateDelta()
 	if self.elapsed >= self.travelTime {
 		self.ax, self.ay = self.bx, self.by
 		self.ctrlx, self.ctrly = self.rollNewPoint()
@@ -121,5 +130,5 @@ func (self *Bezier) rerollOriginPoints() {
 }
 
 func (self *Bezier) rollNewPoint() (float64, float64) {
-	return internal.RollPointWithinEllipse(1.0, 1.0)
+	return internal.RollPointWithinEllipse(self.rng, 1.0, 1.0)
 }
@@ -1,5 +1,9 @@
 package shaker
 
+import "math/rand/v2"
+
+import "github.com/tinne26/mipix/internal"
+
 var _ Shaker = (*Combo)(nil)
 
 // An example [Shaker] created by combining a [Balanced] and
@@ -21,6 +25,16 @@ func (self *Combo) initialize() {
 	self.rand.SetMotionScale(0.02)
 }
 
+// See [Quake.SetRandSource](). The source is shared
+// between the internal shakers.
+func (self *Combo) SetRandSource(source rand.Source) {
+	if !self.initialized { self.initialize() }
+	rng := internal.NewRand(source)
+	self.balanced.rng = rng
+	self.rand.rng = rng
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements [Shaker].
 func (self *Combo) GetShakeOffsets(level float64) (float64, float64) {
 	if !self.initialized { self.initialize() }
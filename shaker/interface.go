@@ -16,6 +16,8 @@
 // [ups-vs-tps]: https://github.com/tinne26/mipix/blob/main/docs/ups-vs-tps.md
 package shaker
 
+import "github.com/tinne26/mipix/internal"
+
 // The interface for mipix screen shakers.
 //
 // Given a level that transitions linearly between 0 and 1
@@ -32,3 +34,24 @@ package shaker
 type Shaker interface {
 	GetShakeOffsets(level float64) (float64, float64)
 }
+
+// An optional extension of the [Shaker] interface for shakers
+// that can also modulate the camera zoom, like punching in on
+// impacts.
+//
+// GetShakeZoom() is invoked right after GetShakeOffsets(), with
+// the same level, including the termination call. The returned
+// value is a relative zoom offset applied on top of the current
+// camera zoom: 0.1 means 10% more zoomed in, -0.1 means 10% more
+// zoomed out. This doesn't affect the zoomer's state, and the
+// results are clamped to [-0.5, 1.0].
+//
+// Rotation channels are not supported, as mipix camera areas
+// are always axis-aligned.
+type ZoomShaker interface {
+	Shaker
+	GetShakeZoom(level float64) float64
+}
+
+// Alias for mipix.TicksDuration.
+type TicksDuration = internal.TicksDuration
@@ -0,0 +1,239 @@
+package shaker
+
+import "fmt"
+import "math"
+import "errors"
+import "encoding/json"
+
+import "github.com/tinne26/mipix/internal"
+
+var _ Shaker = (*Keyframed)(nil)
+
+// A single point of a [Keyframed] shake.
+//
+// The offsets are relative to the game's logical resolution,
+// so X = 0.01 means 1% of the resolution width, and the Easing
+// determines how the previous keyframe's offsets transition to
+// this one.
+type Keyframe struct {
+	Tick TicksDuration `json:"tick"`
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+	Easing Easing `json:"easing"`
+}
+
+// Easing functions available for [Keyframe] transitions.
+// In JSON, easings are represented by their string names.
+type Easing uint8
+const (
+	EaseLinear Easing = iota
+	EaseInQuad
+	EaseOutQuad
+	EaseInOutQuad
+	EaseOutCubic
+	EaseSmoothstep
+
+	easingEndSentinel
+)
+
+// Returns a string representation of the easing. This is also
+// the name used for JSON encoding.
+func (self Easing) String() string {
+	switch self {
+	case EaseLinear    : return "linear"
+	case EaseInQuad    : return "in-quad"
+	case EaseOutQuad   : return "out-quad"
+	case EaseInOutQuad : return "in-out-quad"
+	case EaseOutCubic  : return "out-cubic"
+	case EaseSmoothstep: return "smoothstep"
+	default:
+		panic("invalid Easing")
+	}
+}
+
+// Implements [encoding.TextMarshaler].
+func (self Easing) MarshalText() ([]byte, error) {
+	if self >= easingEndSentinel {
+		return nil, fmt.Errorf("invalid easing value %d", self)
+	}
+	return []byte(self.String()), nil
+}
+
+// Implements [encoding.TextUnmarshaler].
+func (self *Easing) UnmarshalText(text []byte) error {
+	name := string(text)
+	for easing := range easingEndSentinel {
+		if easing.String() == name {
+			*self = easing
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown easing %q", name)
+}
+
+func (self Easing) apply(t float64) float64 {
+	switch self {
+	case EaseLinear    : return internal.Clamp(t, 0, 1)
+	case EaseInQuad    : return internal.EaseInQuad(t)
+	case EaseOutQuad   : return internal.EaseOutQuad(t)
+	case EaseInOutQuad : return internal.QuadInOut(t)
+	case EaseOutCubic  : return internal.EaseOutCubic(t)
+	case EaseSmoothstep: return internal.CubicSmoothstepInterp(0, 1, t)
+	default:
+		panic("invalid Easing")
+	}
+}
+
+// A [Shaker] that plays back a list of keyframes. This allows
+// designers to author specific shakes (e.g., a boss stomp) as
+// data instead of tuning the parameters of random shakers.
+//
+// The offsets are interpolated between keyframes and scaled by
+// the shake level. If the first keyframe is not at tick 0, an
+// implicit keyframe with zero offsets is used as the origin.
+// Once the last keyframe is reached, the shaker holds it or
+// loops back to the start, depending on [Keyframed.SetLoop]().
+//
+// Keyframed shakes can be loaded from JSON, e.g.:
+//   {
+//     "loop": false,
+//     "keyframes": [
+//       { "tick":  6, "x": 0.0, "y":  0.04, "easing": "out-quad" },
+//       { "tick": 18, "x": 0.0, "y": -0.02, "easing": "in-out-quad" },
+//       { "tick": 30, "x": 0.0, "y":  0.0,  "easing": "smoothstep" }
+//     ]
+//   }
+//
+// The implementation is tick-rate independent.
+type Keyframed struct {
+	keyframes []Keyframe
+	loop bool
+	elapsed float64 // in ticks
+	zoomCompensation float64
+}
+
+// Sets the keyframes to play back. Keyframe ticks must be
+// strictly increasing. The slice is copied.
+func (self *Keyframed) SetKeyframes(keyframes []Keyframe) {
+	err := validateKeyframes(keyframes)
+	if err != nil { panic(err.Error()) }
+	self.keyframes = append(self.keyframes[ : 0], keyframes...)
+	self.elapsed = 0
+}
+
+// Returns the keyframes. The slice must not be modified.
+func (self *Keyframed) GetKeyframes() []Keyframe {
+	return self.keyframes
+}
+
+// Sets whether the keyframes should loop once the last one
+// is reached. Defaults to false.
+func (self *Keyframed) SetLoop(loop bool) {
+	self.loop = loop
+}
+
+// Returns the tick of the last keyframe. This is useful to
+// trigger shakes that last exactly as long as the keyframes:
+//   mipix.Camera().TriggerShake(0, keyframed.Duration(), 0)
+func (self *Keyframed) Duration() TicksDuration {
+	if len(self.keyframes) == 0 { return 0 }
+	return self.keyframes[len(self.keyframes) - 1].Tick
+}
+
+// The range of motion of most shakers is based on the logical
+// resolution of the game. This means that when zooming in or
+// out, the shaking effect will become more or less pronounced,
+// respectively. If you want the shaking to maintain the same
+// relative magnitude regardless of zoom level, change the zoom
+// compensation from 0 (the default) to 1.
+func (self *Keyframed) SetZoomCompensation(compensation float64) {
+	if compensation < 0 || compensation > 1.0 {
+		panic("zoom compensation factor must be in [0, 1]")
+	}
+	self.zoomCompensation = compensation
+}
+
+// Implements the [Shaker] interface.
+func (self *Keyframed) GetShakeOffsets(level float64) (float64, float64) {
+	if level == 0.0 {
+		self.elapsed = 0
+		return 0.0, 0.0
+	}
+
+	// sample keyframes and advance time
+	x, y := self.sample(self.elapsed)
+	self.elapsed += internal.GetTickAdvance()
+	duration := self.Duration()
+	if self.loop && duration > 0 {
+		self.elapsed = math.Mod(self.elapsed, float64(duration))
+	}
+
+	// translate relative offsets to real screen offsets
+	w, h := internal.GetResolution()
+	w64, h64 := float64(w), float64(h)
+	zoom := internal.GetCurrentZoom()
+	xOffset, yOffset := x*w64, y*h64
+	if self.zoomCompensation != 0.0 {
+		compensatedZoom := 1.0 + (zoom - 1.0)*self.zoomCompensation
+		xOffset /= compensatedZoom
+		yOffset /= compensatedZoom
+	}
+	if level != 1.0 {
+		xOffset *= level
+		yOffset *= level
+	}
+
+	return xOffset, yOffset
+}
+
+func (self *Keyframed) sample(elapsed float64) (float64, float64) {
+	var prev Keyframe // implicit origin
+	for _, keyframe := range self.keyframes {
+		if elapsed <= float64(keyframe.Tick) {
+			if keyframe.Tick == prev.Tick { return keyframe.X, keyframe.Y }
+			t := (elapsed - float64(prev.Tick))/float64(keyframe.Tick - prev.Tick)
+			t  = keyframe.Easing.apply(t)
+			return internal.LinearInterp(prev.X, keyframe.X, t), internal.LinearInterp(prev.Y, keyframe.Y, t)
+		}
+		prev = keyframe
+	}
+	return prev.X, prev.Y // hold last keyframe
+}
+
+type keyframedJSON struct {
+	Loop bool `json:"loop"`
+	Keyframes []Keyframe `json:"keyframes"`
+}
+
+// Implements [json.Marshaler].
+func (self *Keyframed) MarshalJSON() ([]byte, error) {
+	keyframes := self.keyframes
+	if keyframes == nil { keyframes = []Keyframe{} }
+	return json.Marshal(keyframedJSON{ Loop: self.loop, Keyframes: keyframes })
+}
+
+// Implements [json.Unmarshaler]. The zoom compensation is
+// not part of the JSON data and remains unmodified.
+func (self *Keyframed) UnmarshalJSON(data []byte) error {
+	var decoded keyframedJSON
+	err := json.Unmarshal(data, &decoded)
+	if err != nil { return err }
+	err = validateKeyframes(decoded.Keyframes)
+	if err != nil { return err }
+	self.loop = decoded.Loop
+	self.keyframes = decoded.Keyframes
+	self.elapsed = 0
+	return nil
+}
+
+func validateKeyframes(keyframes []Keyframe) error {
+	for i, keyframe := range keyframes {
+		if keyframe.Easing >= easingEndSentinel {
+			return fmt.Errorf("keyframe #%d has an invalid easing", i)
+		}
+		if i > 0 && keyframe.Tick <= keyframes[i - 1].Tick {
+			return errors.New("keyframe ticks must be strictly increasing")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,165 @@
+package shaker
+
+import "math"
+import "math/rand/v2"
+
+import "github.com/tinne26/mipix/internal"
+
+var _ Shaker = (*Noise)(nil)
+
+// Implementation of a [Shaker] based on coherent gradient noise
+// (1D Perlin noise), sampled independently for each axis.
+//
+// Noise-based shakes are very common for handheld camera effects,
+// subtle vibrations and similar, as the motion is smooth and
+// continuous but still irregular. Lower frequencies result in
+// gentle drifting, higher frequencies in rougher trembling.
+//
+// The implementation is tick-rate independent.
+type Noise struct {
+	elapsed float64 // in seconds
+	seedX, seedY uint64
+
+	frequency float64
+	octaves int
+	axisRatio float64
+	zoomCompensation float64
+	rng *rand.Rand
+	initialized bool
+}
+
+func (self *Noise) ensureInitialized() {
+	if self.initialized { return }
+	self.initialized = true
+	if self.frequency == 0.0 {
+		self.frequency = 12.0
+	}
+	if self.octaves == 0 {
+		self.octaves = 2
+	}
+	if self.axisRatio == 0.0 {
+		self.axisRatio = 0.02
+	}
+	self.rerollSeeds()
+}
+
+// To preserve resolution independence, shakers often simulate the
+// shaking within a [-0.5, 0.5] space and only later scale it. For
+// example, if you have a resolution of 32x32 and set a motion
+// scale of 0.25, the shaking will range within [-4, +4] in both
+// axes.
+//
+// Defaults to 0.02.
+func (self *Noise) SetMotionScale(axisScalingFactor float64) {
+	if axisScalingFactor <= 0.0 { panic("axisScalingFactor must be strictly positive") }
+	self.axisRatio = axisScalingFactor
+}
+
+// The range of motion of most shakers is based on the logical
+// resolution of the game. This means that when zooming in or
+// out, the shaking effect will become more or less pronounced,
+// respectively. If you want the shaking to maintain the same
+// relative magnitude regardless of zoom level, change the zoom
+// compensation from 0 (the default) to 1.
+func (self *Noise) SetZoomCompensation(compensation float64) {
+	if compensation < 0 || compensation > 1.0 {
+		panic("zoom compensation factor must be in [0, 1]")
+	}
+	self.zoomCompensation = compensation
+}
+
+// Sets the base frequency of the noise, in oscillations per
+// second. Values around 1.0 result in soft drifting, while
+// values above 10.0 are better for trembling. Defaults to 12.0.
+func (self *Noise) SetFrequency(frequency float64) {
+	if frequency <= 0.0 { panic("frequency must be strictly positive") }
+	self.frequency = frequency
+}
+
+// Sets the number of noise layers. Each additional octave
+// doubles the frequency and halves the amplitude of the
+// previous one, adding finer detail to the motion. The
+// result is normalized, so the range of motion is preserved.
+// Reasonable values range between [1, 4]. Defaults to 2.
+func (self *Noise) SetOctaves(octaves int) {
+	if octaves < 1 { panic("octaves must be at least 1") }
+	if octaves > 16 { panic("octaves can't exceed 16") }
+	self.octaves = octaves
+}
+
+// See [Quake.SetRandSource]().
+func (self *Noise) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
+// Implements the [Shaker] interface.
+func (self *Noise) GetShakeOffsets(level float64) (float64, float64) {
+	self.ensureInitialized()
+	if level == 0.0 {
+		self.elapsed = 0.0
+		self.rerollSeeds()
+		return 0.0, 0.0
+	}
+
+	// sample noise and advance time
+	x := self.sample(self.seedX, self.elapsed*self.frequency)
+	y := self.sample(self.seedY, self.elapsed*self.frequency)
+	self.elapsed += internal.GetUpdateDelta()
+
+	// translate noise values to real screen offsets
+	w, h := internal.GetResolution()
+	w64, h64 := float64(w), float64(h)
+	zoom := internal.GetCurrentZoom()
+	xOffset, yOffset := x*w64*self.axisRatio, y*h64*self.axisRatio
+	if self.zoomCompensation != 0.0 {
+		compensatedZoom := 1.0 + (zoom - 1.0)*self.zoomCompensation
+		xOffset /= compensatedZoom
+		yOffset /= compensatedZoom
+	}
+	if level != 1.0 {
+		xOffset *= level
+		yOffset *= level
+	}
+
+	return xOffset, yOffset
+}
+
+// Returns a value in [-0.5, 0.5].
+func (self *Noise) sample(seed uint64, t float64) float64 {
+	var value, amplitude, totalAmplitude float64 = 0.0, 1.0, 0.0
+	for octave := range self.octaves {
+		value += noise1D(seed + uint64(octave), t)*amplitude
+		totalAmplitude += amplitude
+		amplitude *= 0.5
+		t *= 2.0
+	}
+	return value/totalAmplitude
+}
+
+func (self *Noise) rerollSeeds() {
+	self.seedX = internal.RandUint64(self.rng)
+	self.seedY = internal.RandUint64(self.rng)
+}
+
+// Gradient noise. Returns values in [-0.5, 0.5], with
+// zeros at integer coordinates.
+func noise1D(seed uint64, t float64) float64 {
+	floor := math.Floor(t)
+	frac  := t - floor
+	lattice := uint64(int64(floor))
+	g0 := noiseGradient(seed, lattice)
+	g1 := noiseGradient(seed, lattice + 1)
+	fade := frac*frac*frac*(frac*(frac*6.0 - 15.0) + 10.0) // quintic smootherstep
+	return internal.LinearInterp(g0*frac, g1*(frac - 1.0), fade)
+}
+
+// Returns a pseudo-random gradient in [-1, 1] for the given lattice point.
+func noiseGradient(seed, lattice uint64) float64 {
+	// splitmix64 finalizer
+	z := seed ^ (lattice*0x9E3779B97F4A7C15)
+	z = (z ^ (z >> 30))*0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27))*0x94D049BB133111EB
+	z ^= (z >> 31)
+	return float64(z >> 11)/float64(1 << 52) - 1.0
+}
@@ -0,0 +1,53 @@
+package shaker
+
+import "github.com/tinne26/mipix/internal"
+
+var _ ZoomShaker = (*Punch)(nil)
+
+// A [ZoomShaker] that zooms in proportionally to the shake level,
+// creating a "punch-in" effect. The shape of the punch depends
+// on the fade in and fade out of the shake itself, so you
+// typically want to trigger it with a very short fade in and
+// a longer fade out, e.g.:
+//   mipix.Camera().SetShaker(&punch)
+//   mipix.Camera().TriggerShake(4, 0, 30)
+//
+// The punch doesn't move the camera on its own, but you can set
+// the Shaker field to any other [Shaker] in order to combine the
+// zoom with regular shake offsets.
+type Punch struct {
+	Shaker Shaker
+	zoomFactor float64
+	initialized bool
+}
+
+func (self *Punch) ensureInitialized() {
+	if self.initialized { return }
+	self.initialized = true
+	if self.zoomFactor == 0.0 {
+		self.zoomFactor = 0.08
+	}
+}
+
+// Sets the relative zoom offset reached at the peak of the punch.
+// For example, 0.1 means 10% more zoomed in. Negative values can
+// be used to zoom out instead. Defaults to 0.08.
+func (self *Punch) SetZoomFactor(factor float64) {
+	if factor < -0.5 || factor > 1.0 {
+		panic("zoom factor must be in [-0.5, 1.0] range")
+	}
+	if factor == 0.0 { panic("zoom factor can't be zero") }
+	self.zoomFactor = factor
+}
+
+// Implements the [Shaker] interface.
+func (self *Punch) GetShakeOffsets(level float64) (float64, float64) {
+	if self.Shaker == nil { return 0.0, 0.0 }
+	return self.Shaker.GetShakeOffsets(level)
+}
+
+// Implements the [ZoomShaker] interface.
+func (self *Punch) GetShakeZoom(level float64) float64 {
+	self.ensureInitialized()
+	return internal.CubicSmoothstepInterp(0, self.zoomFactor, level)
+}
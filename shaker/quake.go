@@ -23,6 +23,7 @@ type Quake struct {
 	minSpeed, maxSpeed float64 // absolute values
 	axisRatio float64
 	zoomCompensation float64
+	rng *rand.Rand
 	initialized bool
 }
 
@@ -79,6 +80,20 @@ func (self *Quake) SetZoomCompensation(compensation float64) {
 	self.zoomCompensation = compensation
 }
 
+// Sets the source of randomness for the shaker. By default,
+// the global [rand] source is used, but setting a specific
+// source with a fixed seed makes shakes reproducible, which
+// can be useful for replays and tests. For example:
+//   myShaker.SetRandSource(rand.NewPCG(seed1, seed2))
+//
+// Setting a source resets the shaker, rerolling its internal
+// state from the new source, so it shouldn't be done while a
+// shake is in progress. Passing nil restores the global source.
+func (self *Quake) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements the [Shaker] interface.
 func (self *Quake) GetShakeOffsets(level float64) (float64, float64) {
 	self.ensureInitialized()
@@ -91,7 +106,7 @@ func (self *Quake) GetShakeOffsets(level float64) (float64, float64) {
 	}
 	
 	// update x/y
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	t := internal.TAt(self.x, self.fromX, self.towardsX)
 	self.x += internal.LinearInterp(self.xSpeedIni, self.xSpeedEnd, t)*updateDelta
 	if internal.TAt(self.x, self.fromX, self.towardsX) >= 1.0 {
@@ -124,16 +139,16 @@ func (self *Quake) GetShakeOffsets(level float64) (float64, float64) {
 }
 
 func (self *Quake) reroll(value, speed float64) (target, iniSpeed, endSpeed float64) {
-	if value > 0.0 || (value == 0.0 && rand.Float64() < 0.5) {
-		target = -(0.05 + rand.Float64()*0.45)
+	if value > 0.0 || (value == 0.0 && internal.RandFloat64(self.rng) < 0.5) {
+		target = -(0.05 + internal.RandFloat64(self.rng)*0.45)
 		iniSpeed = -max(internal.Abs(speed), self.minSpeed)
-		endSpeed = -(self.minSpeed + rand.Float64()*(self.maxSpeed - self.minSpeed))
+		endSpeed = -(self.minSpeed + internal.RandFloat64(self.rng)*(self.maxSpeed - self.minSpeed))
 		speedDiff := (endSpeed - iniSpeed)*(internal.Abs(target - value))
 		endSpeed = iniSpeed + speedDiff
 	} else { // value < 0.0
-		target = (0.05 + rand.Float64()*0.45)
+		target = (0.05 + internal.RandFloat64(self.rng)*0.45)
 		iniSpeed = max(internal.Abs(speed), self.minSpeed)
-		endSpeed = (self.minSpeed + rand.Float64()*(self.maxSpeed - self.minSpeed))
+		endSpeed = (self.minSpeed + internal.RandFloat64(self.rng)*(self.maxSpeed - self.minSpeed))
 		speedDiff := (endSpeed - iniSpeed)*(internal.Abs(target - value))
 		endSpeed = iniSpeed + speedDiff
 	}
@@ -22,6 +22,7 @@ type Random struct {
 	travelTime float64
 	axisRatio float64
 	zoomCompensated bool
+	rng *rand.Rand
 	initialized bool
 }
 
@@ -67,6 +68,12 @@ func (self *Random) SetTravelTime(travelTime float64) {
 	self.travelTime = travelTime
 }
 
+// See [Quake.SetRandSource]().
+func (self *Random) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements the [Shaker] interface.
 func (self *Random) GetShakeOffsets(level float64) (float64, float64) {
 	self.ensureInitialized()
@@ -80,7 +87,7 @@ func (self *Random) GetShakeOffsets(level float64) (float64, float64) {
 	t := self.elapsed/self.travelTime
 	x := internal.QuadInOutInterp(self.fromX, self.toX, t)
 	y := internal.QuadInOutInterp(self.fromY, self.toY, t)
-	self.elapsed += 1.0/float64(internal.GetUPS())
+	self.elapsed += internal.GetUpdateDelta()
 	if self.elapsed >= self.travelTime {
 		self.rollNewTarget()
 		for self.elapsed >= self.travelTime {
@@ -102,6 +109,6 @@ func (self *Random) GetShakeOffsets(level float64) (float64, float64) {
 
 func (self *Random) rollNewTarget() {
 	self.fromX, self.fromY = self.toX, self.toY
-	self.toX = rand.Float64() - 0.5
-	self.toY = rand.Float64() - 0.5
+	self.toX = internal.RandFloat64(self.rng) - 0.5
+	self.toY = internal.RandFloat64(self.rng) - 0.5
 }
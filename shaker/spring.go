@@ -21,6 +21,7 @@ type Spring struct {
 
 	xRatio, yRatio float64
 	zoomCompensation float64
+	rng *rand.Rand
 	initialized bool
 }
 
@@ -75,6 +76,12 @@ func (self *Spring) SetParameters(damping, power float64) {
 	self.spring.SetParameters(damping, power)
 }
 
+// See [Quake.SetRandSource]().
+func (self *Spring) SetRandSource(source rand.Source) {
+	self.rng = internal.NewRand(source)
+	self.GetShakeOffsets(0.0) // reroll state from the new source
+}
+
 // Implements the [Shaker] interface.
 func (self *Spring) GetShakeOffsets(level float64) (float64, float64) {
 	self.ensureInitialized()
@@ -111,5 +118,5 @@ func (self *Spring) GetShakeOffsets(level float64) (float64, float64) {
 }
 
 func (self *Spring) rerollTarget() {
-	self.xTarget, self.yTarget = rand.Float64() - 0.5, rand.Float64() - 0.5
+	self.xTarget, self.yTarget = internal.RandFloat64(self.rng) - 0.5, internal.RandFloat64(self.rng) - 0.5
 }
@@ -0,0 +1,61 @@
+package tracker
+
+var _ Tracker = (*Axes)(nil)
+var _ Resetter = (*Axes)(nil)
+var _ Settler = (*Axes)(nil)
+
+// A composite tracker that uses a different [Tracker] for
+// each axis. This is common in platformers, where horizontal
+// tracking tends to be fast and responsive, while vertical
+// tracking is lazier and only reacts to significant changes
+// (see [PlatformSnap]).
+//
+// Each tracker only sees the movement of its own axis: the
+// target coordinate for the other axis is always reported as
+// equal to the current one. Nil trackers behave like [Instant].
+type Axes struct {
+	X Tracker
+	Y Tracker
+	movingX bool
+	movingY bool
+}
+
+// Implements [Resetter]. Only the axis trackers that
+// implement [Resetter] themselves are reset.
+func (self *Axes) Reset() {
+	if resetter, ok := self.X.(Resetter); ok {
+		resetter.Reset()
+	}
+	if resetter, ok := self.Y.(Resetter); ok {
+		resetter.Reset()
+	}
+	self.movingX, self.movingY = false, false
+}
+
+// Implements [Settler]. Each axis is settled when its tracker
+// reports itself as settled or, if it doesn't implement [Settler],
+// when its last update didn't move the camera.
+func (self *Axes) IsSettled() bool {
+	return isAxisSettled(self.X, self.movingX) && isAxisSettled(self.Y, self.movingY)
+}
+
+// Implements [Tracker].
+func (self *Axes) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
+	changeX := targetX - currentX
+	if self.X != nil {
+		changeX, _ = self.X.Update(currentX, currentY, targetX, currentY, prevSpeedX, 0.0)
+	}
+	changeY := targetY - currentY
+	if self.Y != nil {
+		_, changeY = self.Y.Update(currentX, currentY, currentX, targetY, 0.0, prevSpeedY)
+	}
+	self.movingX, self.movingY = (changeX != 0.0), (changeY != 0.0)
+	return changeX, changeY
+}
+
+func isAxisSettled(axisTracker Tracker, moving bool) bool {
+	if settler, ok := axisTracker.(Settler); ok {
+		return settler.IsSettled()
+	}
+	return !moving
+}
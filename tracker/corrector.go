@@ -20,6 +20,10 @@ func (self *corrector) SetAcceleration(acceleration float64) {
 	self.acceleration = acceleration
 }
 
+func (self *corrector) Reset() {
+	self.speedX, self.speedY = 0.0, 0.0
+}
+
 func (self *corrector) Update(errorX, errorY float64) {
 	if !self.initialized { self.initialize() }
 
@@ -34,7 +38,7 @@ func (self *corrector) Update(errorX, errorY float64) {
 	targetX := internal.Abs(errorX)
 	targetY := internal.Abs(errorY)
 	
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	speedChange := self.acceleration*updateDelta
 	margin := (speedChange*speedChange)/(2.0*self.acceleration)
 
@@ -104,7 +108,7 @@ func (self *corrector) Update(errorX, errorY float64) {
 }
 
 func (self *corrector) Decelerate() {
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	speedChange := self.acceleration*updateDelta
 	
 	if self.speedX != 0.0 {
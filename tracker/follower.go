@@ -32,6 +32,12 @@ func (self *follower) SetTimes(engage, disengage float64) {
 	self.haltRequiredDuration  = disengage
 }
 
+func (self *follower) Reset() {
+	self.engaged = false
+	self.elapsedMatch = 0.0
+	self.elapsedHalt = 0.0
+}
+
 func (self *follower) IsEngaged() bool {
 	return self.engaged
 }
@@ -40,7 +46,7 @@ func (self *follower) Update(changeX, changeY, prevSpeedX, prevSpeedY float64) {
 	if !self.initialized { self.initialize() }
 
 	// helper values
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	speedX, speedY := internal.Abs(changeX/updateDelta), internal.Abs(changeY/updateDelta)
 	w, h := internal.GetResolution()
 	w64, h64 := float64(w), float64(h)
@@ -4,6 +4,7 @@ package tracker
 var Frozen Tracker = frozenTracker{}
 
 type frozenTracker struct {}
+func (frozenTracker) Reset() {}
 func (frozenTracker) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	return 0, 0
 }
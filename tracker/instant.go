@@ -4,6 +4,7 @@ package tracker
 var Instant Tracker = instantTracker{}
 
 type instantTracker struct{}
+func (instantTracker) Reset() {}
 func (instantTracker) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	return targetX - currentX, targetY - currentY
 }
@@ -23,3 +23,28 @@ package tracker
 type Tracker interface {
 	Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64)
 }
+
+// An optional interface for trackers with internal state, like
+// speeds or timers, that needs to be cleared when the camera
+// coordinates are reset. Otherwise, the first updates after a
+// reset might lurch due to the previous state.
+//
+// All built-in trackers implement this interface.
+type Resetter interface {
+	Reset()
+}
+
+// An optional interface for trackers that can report whether
+// they have come to rest at their destination. When implemented,
+// the camera uses it instead of a distance check to decide whether
+// the tracking target has been reached. This matters for spring
+// based trackers, which can momentarily reach the target while
+// still oscillating around it, but also for trackers that don't
+// aim at the target itself, like [Rooms] or [Rail].
+//
+// Most built-in trackers implement this interface. [Instant], [Linear],
+// [Parametrized] and [Frozen] don't, as the distance check is enough
+// for them.
+type Settler interface {
+	IsSettled() bool
+}
@@ -7,6 +7,7 @@ var Linear Tracker = linearTracker{}
 
 // A simple linear interpolation tracker.
 type linearTracker struct {}
+func (linearTracker) Reset() {}
 
 func (self linearTracker) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	// stabilization
@@ -19,7 +20,7 @@ func (self linearTracker) Update(currentX, currentY, targetX, targetY, prevSpeed
 	zoom := internal.GetCurrentZoom()
 	widthF64, heightF64 := float64(w)/zoom, float64(h)/zoom
 	
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	maxHorzAdvance := 6.0*zoom*widthF64*updateDelta  // use higher values for a more rigid / strict tracking
 	maxVertAdvance := 6.0*zoom*heightF64*updateDelta // use lower values for a more elastic / softer tracking
 	minAdvance := 0.01*updateDelta
@@ -3,6 +3,7 @@ package tracker
 import "github.com/tinne26/mipix/internal"
 
 var _ Tracker = (*Parametrized)(nil)
+var _ Resetter = (*Parametrized)(nil)
 
 // A configurable linear tracker.
 type Parametrized struct {
@@ -58,6 +59,10 @@ func (self *Parametrized) SetFrozenTrackingBelow(screens float64) {
 	self.screensToMinSpeed = screens
 }
 
+// Implements [Resetter]. Parametrized has no
+// internal state, so this is a no-op.
+func (self *Parametrized) Reset() {}
+
 // Implements [Tracker].
 func (self *Parametrized) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	if !self.initialized { self.initialize() }
@@ -65,7 +70,7 @@ func (self *Parametrized) Update(currentX, currentY, targetX, targetY, prevSpeed
 	w, h := internal.GetResolution()
 	widthF64, heightF64 := float64(w), float64(h)
 	
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	xAdvance := self.updateComponent(currentX, targetX, widthF64 , updateDelta)
 	yAdvance := self.updateComponent(currentY, targetY, heightF64, updateDelta)
 	return xAdvance, yAdvance
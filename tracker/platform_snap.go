@@ -0,0 +1,98 @@
+package tracker
+
+import "github.com/tinne26/mipix/internal"
+
+var _ Tracker = (*PlatformSnap)(nil)
+var _ Resetter = (*PlatformSnap)(nil)
+var _ Settler = (*PlatformSnap)(nil)
+
+// A vertical tracker for platformers. While the target is
+// grounded, the camera follows it as usual, but while the
+// target is airborne (jumping, falling off ledges...), the
+// camera stays at the last ground level and only moves if the
+// target gets too far away from it. This avoids the camera
+// bobbing up and down on every jump.
+//
+// The game must notify the grounded state of the target through
+// [PlatformSnap.SetGrounded](). The vertical motion itself uses
+// a [Parametrized] tracker, which you can access and configure
+// directly as a struct field.
+//
+// PlatformSnap only moves the camera vertically, so it's meant to
+// be used as the Y tracker of an [Axes] composite. For example:
+//   var platSnap tracker.PlatformSnap
+//   mipix.Camera().SetTracker(&tracker.Axes{ X: &tracker.SpringTailer{}, Y: &platSnap })
+type PlatformSnap struct {
+	Parametrized Parametrized
+	anchorY float64
+	anchored bool
+	airborne bool
+	airMargin float64
+	moving bool
+	initialized bool
+}
+
+func (self *PlatformSnap) ensureInitialized() {
+	if self.initialized { return }
+	self.initialized = true
+	if self.airMargin == 0.0 {
+		self.airMargin = 0.35
+	}
+}
+
+// Notifies whether the target is standing on the ground or
+// not. Typically called on every update, right before or after
+// mipix.Camera().NotifyCoordinates(). Defaults to true.
+func (self *PlatformSnap) SetGrounded(grounded bool) {
+	self.airborne = !grounded
+}
+
+// Sets the maximum vertical distance, in screens, that the
+// target can move away from the last ground level while airborne
+// before the camera starts following it. Defaults to 0.35.
+func (self *PlatformSnap) SetAirMargin(screens float64) {
+	if screens < 0.0 { panic("air margin can't be negative") }
+	self.ensureInitialized()
+	self.airMargin = screens
+}
+
+// Implements [Resetter].
+func (self *PlatformSnap) Reset() {
+	self.Parametrized.Reset()
+	self.anchored = false
+	self.moving = false
+}
+
+// Implements [Settler]. While airborne, the camera can be
+// settled at the last ground level even if the target is
+// still far from it.
+func (self *PlatformSnap) IsSettled() bool {
+	return !self.moving
+}
+
+// Implements [Tracker].
+func (self *PlatformSnap) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
+	self.ensureInitialized()
+	if !self.anchored {
+		self.anchorY = currentY
+		self.anchored = true
+	}
+
+	// update anchor
+	if !self.airborne {
+		self.anchorY = targetY
+	} else {
+		_, h := internal.GetResolution()
+		margin := self.airMargin*float64(h)/internal.GetCurrentZoom()
+		if targetY < self.anchorY - margin {
+			self.anchorY = targetY + margin
+		} else if targetY > self.anchorY + margin {
+			self.anchorY = targetY - margin
+		}
+	}
+
+	// move towards the anchor
+	_, changeY := self.Parametrized.Update(currentX, currentY, currentX, self.anchorY, prevSpeedX, prevSpeedY)
+	self.moving = (changeY != 0.0)
+	return 0.0, changeY
+}
@@ -0,0 +1,217 @@
+package tracker
+
+import "math"
+
+import "github.com/tinne26/mipix/internal"
+
+var _ Tracker = (*Rail)(nil)
+var _ Resetter = (*Rail)(nil)
+var _ Settler = (*Rail)(nil)
+
+// A point in world coordinates. See [Rail.SetPath]().
+type Point struct {
+	X, Y float64
+}
+
+// A tracker that constrains the camera to a path. The target is
+// projected onto the path, and the projected point is passed to
+// an inner tracker, which you can set directly as a struct field
+// for smoothing. A nil inner tracker behaves like [Instant].
+//
+// Rails are common for auto-scrollers and side-scrolling set
+// pieces. For those, [Rail.SetMinForwardSpeed]() can be used to
+// keep the camera moving forward even if the target stops.
+type Rail struct {
+	Tracker Tracker
+
+	path []Point
+	smooth bool
+	minForwardSpeed float64 // in screens per second
+
+	// polyline cache (sampled from path if smooth)
+	points []Point
+	lengths []float64 // cumulative lengths at each point
+	dirty bool
+
+	progress float64 // distance along the polyline
+	hasProgress bool
+	moving bool
+}
+
+// Sets the path points, in world coordinates. The path is
+// traversed from the first to the last point. Without any
+// points, the target is passed to the inner tracker unchanged.
+// Setting a new path resets the progress along it. The points
+// are copied, so the slice can be modified afterwards to build
+// a different path.
+func (self *Rail) SetPath(points []Point) {
+	self.path = append(self.path[ : 0], points...)
+	self.dirty = true
+	self.hasProgress = false
+}
+
+// When set, the path is interpreted as the control points of a
+// Catmull-Rom spline passing through them, instead of a polyline.
+// Defaults to false.
+func (self *Rail) SetSmooth(smooth bool) {
+	if smooth == self.smooth { return }
+	self.smooth = smooth
+	self.dirty = true
+}
+
+// Sets a minimum speed, in screens per second, at which the
+// projected point advances along the path. When the minimum
+// forward speed is set, the projected point also becomes
+// monotonic, never moving back along the path. Zero disables
+// the feature, which is the default.
+func (self *Rail) SetMinForwardSpeed(screensPerSecond float64) {
+	if screensPerSecond < 0.0 { panic("min forward speed can't be negative") }
+	self.minForwardSpeed = screensPerSecond
+}
+
+// Returns the distance travelled along the path by the
+// projected point, in world units.
+func (self *Rail) Progress() float64 {
+	return self.progress
+}
+
+// Implements [Resetter]. The inner tracker is
+// also reset if it implements [Resetter].
+func (self *Rail) Reset() {
+	self.hasProgress = false
+	if resetter, ok := self.Tracker.(Resetter); ok {
+		resetter.Reset()
+	}
+	self.moving = false
+}
+
+// Implements [Settler]. The rail is settled when its last update
+// didn't move the camera and the inner tracker, if it implements
+// [Settler], also reports itself as settled. With a minimum forward
+// speed, this only happens at the end of the path.
+func (self *Rail) IsSettled() bool {
+	if self.moving { return false }
+	if settler, ok := self.Tracker.(Settler); ok {
+		return settler.IsSettled()
+	}
+	return true
+}
+
+// Implements [Tracker].
+func (self *Rail) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
+	if self.dirty { self.rebuild() }
+	if len(self.points) > 0 {
+		targetX, targetY = self.projectTarget(targetX, targetY)
+	}
+	changeX, changeY := targetX - currentX, targetY - currentY
+	if self.Tracker != nil {
+		changeX, changeY = self.Tracker.Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY)
+	}
+	self.moving = (changeX != 0.0 || changeY != 0.0)
+	return changeX, changeY
+}
+
+func (self *Rail) projectTarget(x, y float64) (float64, float64) {
+	if len(self.points) == 1 { return self.points[0].X, self.points[0].Y }
+
+	// find closest point along the path
+	progress := 0.0
+	bestDistSq := math.Inf(1)
+	for i := 1; i < len(self.points); i++ {
+		a, b := self.points[i - 1], self.points[i]
+		segLen := self.lengths[i] - self.lengths[i - 1]
+		if segLen == 0.0 { continue }
+		dx, dy := b.X - a.X, b.Y - a.Y
+		t := internal.Clamp(((x - a.X)*dx + (y - a.Y)*dy)/(segLen*segLen), 0.0, 1.0)
+		px, py := a.X + dx*t, a.Y + dy*t
+		distSq := (x - px)*(x - px) + (y - py)*(y - py)
+		if distSq < bestDistSq {
+			bestDistSq = distSq
+			progress = self.lengths[i - 1] + segLen*t
+		}
+	}
+
+	// apply forward constraints
+	if self.minForwardSpeed > 0.0 && self.hasProgress {
+		progress = max(progress, self.progress + self.minForwardAdvance())
+		progress = min(progress, self.lengths[len(self.lengths) - 1])
+	}
+	self.progress = progress
+	self.hasProgress = true
+	return self.pointAt(progress)
+}
+
+// Returns the min forward advance for a single update, in world
+// units, based on the direction of the path at the current progress.
+func (self *Rail) minForwardAdvance() float64 {
+	i := self.segmentAt(self.progress)
+	a, b := self.points[i - 1], self.points[i]
+	segLen := self.lengths[i] - self.lengths[i - 1]
+	dirX, dirY := 1.0, 0.0
+	if segLen > 0.0 {
+		dirX, dirY = (b.X - a.X)/segLen, (b.Y - a.Y)/segLen
+	}
+	w, h := internal.GetResolution()
+	screen := math.Hypot(dirX*float64(w), dirY*float64(h))/internal.GetCurrentZoom()
+	return self.minForwardSpeed*screen*internal.GetUpdateDelta()
+}
+
+// Returns the index of the end point of the segment
+// containing the given progress.
+func (self *Rail) segmentAt(progress float64) int {
+	if len(self.points) < 2 { return 0 }
+	for i := 1; i < len(self.points) - 1; i++ {
+		if progress < self.lengths[i] { return i }
+	}
+	return len(self.points) - 1
+}
+
+func (self *Rail) pointAt(progress float64) (float64, float64) {
+	i := self.segmentAt(progress)
+	a, b := self.points[i - 1], self.points[i]
+	segLen := self.lengths[i] - self.lengths[i - 1]
+	if segLen == 0.0 { return b.X, b.Y }
+	t := internal.Clamp((progress - self.lengths[i - 1])/segLen, 0.0, 1.0)
+	return internal.LinearInterp(a.X, b.X, t), internal.LinearInterp(a.Y, b.Y, t)
+}
+
+const railSplineSamples = 12 // samples per spline segment
+
+func (self *Rail) rebuild() {
+	self.dirty = false
+	self.points = self.points[ : 0]
+	if !self.smooth || len(self.path) < 3 {
+		self.points = append(self.points, self.path...)
+	} else {
+		last := len(self.path) - 1
+		for i := range last {
+			p0 := self.path[max(i - 1, 0)]
+			p1, p2 := self.path[i], self.path[i + 1]
+			p3 := self.path[min(i + 2, last)]
+			for n := range railSplineSamples {
+				t := float64(n)/railSplineSamples
+				self.points = append(self.points, catmullRom(p0, p1, p2, p3, t))
+			}
+		}
+		self.points = append(self.points, self.path[last])
+	}
+
+	self.lengths = self.lengths[ : 0]
+	var length float64
+	for i := range self.points {
+		if i > 0 {
+			a, b := self.points[i - 1], self.points[i]
+			length += math.Hypot(b.X - a.X, b.Y - a.Y)
+		}
+		self.lengths = append(self.lengths, length)
+	}
+}
+
+// Uniform Catmull-Rom spline evaluation between p1 and p2.
+func catmullRom(p0, p1, p2, p3 Point, t float64) Point {
+	t2, t3 := t*t, t*t*t
+	eval := func(a, b, c, d float64) float64 {
+		return 0.5*((2.0*b) + (c - a)*t + (2.0*a - 5.0*b + 4.0*c - d)*t2 + (3.0*b - a - 3.0*c + d)*t3)
+	}
+	return Point{ eval(p0.X, p1.X, p2.X, p3.X), eval(p0.Y, p1.Y, p2.Y, p3.Y) }
+}
@@ -0,0 +1,170 @@
+package tracker
+
+import "math"
+import "image"
+
+import "github.com/tinne26/mipix/internal"
+
+var _ Tracker = (*Rooms)(nil)
+var _ Resetter = (*Rooms)(nil)
+var _ Settler = (*Rooms)(nil)
+
+// A tracker for room-based layouts, like classic top-down
+// adventure games. The world is divided into rooms, and the
+// camera stays still within the current room until the target
+// crosses into another one, at which point the camera scrolls
+// to the new room with an eased transition.
+//
+// By default, rooms are cells of the same size as the game's
+// logical resolution, but both the cell size and arbitrary room
+// rectangles can be configured. When a room is bigger than the
+// camera's view, the camera follows the target within the room,
+// clamped to its bounds. When it's smaller, the camera is
+// centered on it.
+//
+// When the target is not within any room, the camera remains
+// in the last room. If no room has been entered yet, the target
+// is tracked instantly instead.
+//
+// The transition time is measured in seconds, so the tracker
+// is tick-rate independent.
+type Rooms struct {
+	rooms []image.Rectangle
+	cellWidth int
+	cellHeight int
+	transitionTime float64 // in seconds
+	easing func(t float64) float64
+
+	room image.Rectangle
+	inRoom bool
+	transitioning bool
+	fromX, fromY float64
+	elapsed float64 // in seconds
+	initialized bool
+}
+
+func (self *Rooms) ensureInitialized() {
+	if self.initialized { return }
+	self.initialized = true
+	self.transitionTime = 0.5
+}
+
+// Sets the size of the grid cells used as rooms when no
+// explicit rooms are set through [Rooms.SetRooms](). Passing
+// zeros restores the default, which is the game's logical
+// resolution.
+func (self *Rooms) SetCellSize(width, height int) {
+	if width < 0 || height < 0 { panic("cell size can't be negative") }
+	if (width == 0) != (height == 0) { panic("cell width and height must be both zero or both positive") }
+	self.cellWidth, self.cellHeight = width, height
+}
+
+// Sets explicit room rectangles, in world coordinates. When the
+// target is inside multiple rooms, the first one takes priority.
+// Passing an empty slice restores the default grid cells (see
+// [Rooms.SetCellSize]()). Rooms are stored by value, so editing
+// the slice afterwards doesn't change the layout.
+func (self *Rooms) SetRooms(rooms []image.Rectangle) {
+	self.rooms = append(self.rooms[ : 0], rooms...)
+}
+
+// Sets the duration of the transitions between rooms, in
+// seconds. Zero makes transitions instantaneous. Defaults
+// to 0.5.
+func (self *Rooms) SetTransitionTime(seconds float64) {
+	if seconds < 0.0 { panic("transition time can't be negative") }
+	self.ensureInitialized()
+	self.transitionTime = seconds
+}
+
+// Sets the easing used to scroll from the previous room to the
+// new one. The [motion] package provides many common easings:
+//   rooms.SetEasing(motion.EaseInOutCubic)
+// Defaults to quadratic in-out, which is also used if nil is
+// passed.
+//
+// [motion]: https://pkg.go.dev/github.com/tinne26/mipix/motion
+func (self *Rooms) SetEasing(easing func(t float64) float64) {
+	self.easing = easing
+}
+
+// Implements [Resetter]. The next update will snap the camera
+// directly to the target's room, without a transition.
+func (self *Rooms) Reset() {
+	self.inRoom = false
+	self.transitioning = false
+}
+
+// Implements [Settler]. Outside transitions, the camera
+// is always at its destination within the current room.
+func (self *Rooms) IsSettled() bool {
+	return !self.transitioning
+}
+
+// Implements [Tracker].
+func (self *Rooms) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
+	self.ensureInitialized()
+
+	// determine current room
+	room, found := self.findRoom(targetX, targetY)
+	if !found && !self.inRoom {
+		return targetX - currentX, targetY - currentY
+	}
+	if found && (!self.inRoom || room != self.room) {
+		if self.inRoom && self.transitionTime > 0.0 {
+			self.transitioning = true
+			self.fromX, self.fromY = currentX, currentY
+			self.elapsed = 0.0
+		}
+		self.room = room
+		self.inRoom = true
+	}
+
+	// compute camera position within the room
+	x, y := self.clampToRoom(targetX, targetY)
+	if self.transitioning {
+		self.elapsed += internal.GetUpdateDelta()
+		t := min(self.elapsed/self.transitionTime, 1.0)
+		if t >= 1.0 {
+			self.transitioning = false
+		} else {
+			if self.easing == nil {
+				t = internal.QuadInOut(t)
+			} else {
+				t = self.easing(t)
+			}
+			x = internal.LinearInterp(self.fromX, x, t)
+			y = internal.LinearInterp(self.fromY, y, t)
+		}
+	}
+
+	return x - currentX, y - currentY
+}
+
+func (self *Rooms) findRoom(x, y float64) (image.Rectangle, bool) {
+	if len(self.rooms) > 0 {
+		for _, room := range self.rooms {
+			if x >= float64(room.Min.X) && x < float64(room.Max.X) && y >= float64(room.Min.Y) && y < float64(room.Max.Y) {
+				return room, true
+			}
+		}
+		return image.Rectangle{}, false
+	}
+
+	cellWidth, cellHeight := self.cellWidth, self.cellHeight
+	if cellWidth == 0 {
+		cellWidth, cellHeight = internal.GetResolution()
+	}
+	cellX := int(math.Floor(x/float64(cellWidth)))
+	cellY := int(math.Floor(y/float64(cellHeight)))
+	minX, minY := cellX*cellWidth, cellY*cellHeight
+	return image.Rect(minX, minY, minX + cellWidth, minY + cellHeight), true
+}
+
+func (self *Rooms) clampToRoom(x, y float64) (float64, float64) {
+	w, h := internal.GetResolution()
+	zoom := internal.GetCurrentZoom()
+	halfWidth, halfHeight := float64(w)/(zoom*2.0), float64(h)/(zoom*2.0)
+	return internal.ClampToSpan(x, float64(self.room.Min.X), float64(self.room.Max.X), halfWidth),
+	       internal.ClampToSpan(y, float64(self.room.Min.Y), float64(self.room.Max.Y), halfHeight)
+}
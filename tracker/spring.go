@@ -2,6 +2,10 @@ package tracker
 
 import "github.com/tinne26/mipix/internal"
 
+var _ Tracker = (*Spring)(nil)
+var _ Resetter = (*Spring)(nil)
+var _ Settler = (*Spring)(nil)
+
 type Spring struct {
 	spring internal.Spring
 	speedX, speedY float64
@@ -20,6 +24,16 @@ func (self *Spring) SetParameters(damping, power float64) {
 	self.initialized = true
 }
 
+// Implements [Resetter].
+func (self *Spring) Reset() {
+	self.speedX, self.speedY = 0.0, 0.0
+}
+
+// Implements [Settler].
+func (self *Spring) IsSettled() bool {
+	return self.speedX == 0.0 && self.speedY == 0.0
+}
+
 func (self *Spring) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	// initialization
 	if !self.initialized { self.initialize() }
@@ -19,10 +19,14 @@ func (self *springCorrector) SetParameters(damping, power float64) {
 	self.spring.SetParameters(damping, power)
 }
 
+func (self *springCorrector) Reset() {
+	self.speedX, self.speedY = 0.0, 0.0
+}
+
 func (self *springCorrector) Update(errorX, errorY float64) {
 	if !self.initialized { self.initialize() }
 
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	w, h := internal.GetResolution()
 	w64, h64 := float64(w), float64(h)
 	errorX /= w64
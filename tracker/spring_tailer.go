@@ -2,6 +2,10 @@ package tracker
 
 import "github.com/tinne26/mipix/internal"
 
+var _ Tracker = (*SpringTailer)(nil)
+var _ Resetter = (*SpringTailer)(nil)
+var _ Settler = (*SpringTailer)(nil)
+
 // Note: I could have a generic Tailer[T], but anyone who wants
 // to write their own stuff can figure it out.
 
@@ -32,13 +36,25 @@ func (self *SpringTailer) SetCatchUpTimes(engage, disengage float64) {
 	self.follower.SetTimes(engage, disengage)
 }
 
+// Implements [Resetter].
+func (self *SpringTailer) Reset() {
+	self.Spring.Reset()
+	self.follower.Reset()
+	self.corrector.Reset()
+}
+
+// Implements [Settler].
+func (self *SpringTailer) IsSettled() bool {
+	return self.Spring.IsSettled() && self.corrector.speedX == 0.0 && self.corrector.speedY == 0.0
+}
+
 // Implements [Tracker].
 func (self *SpringTailer) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	// pre-subtract correction
 	w, h := internal.GetResolution()
 	w64, h64 := float64(w), float64(h)
 	zoom := internal.GetCurrentZoom()
-	updateDelta := 1.0/(float64(internal.GetUPS()))
+	updateDelta := internal.GetUpdateDelta()
 	relCurrentX := currentX - (self.corrector.speedX*w64*updateDelta)/zoom
 	relCurrentY := currentY - (self.corrector.speedY*h64*updateDelta)/zoom
 
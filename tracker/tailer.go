@@ -2,6 +2,10 @@ package tracker
 
 import "github.com/tinne26/mipix/internal"
 
+var _ Tracker = (*Tailer)(nil)
+var _ Resetter = (*Tailer)(nil)
+var _ Settler = (*Tailer)(nil)
+
 // A tracker that uses a [Parametrized] implementation as its base,
 // which you can access and configure directly as a struct field,
 // and then adds a catch up mechanism that triggers after you move
@@ -10,6 +14,7 @@ type Tailer struct {
 	Parametrized Parametrized
 	follower follower
 	corrector corrector
+	moving bool
 }
 
 // Once the catching up mechanism is triggered, it uses a static
@@ -31,13 +36,26 @@ func (self *Tailer) SetCatchUpTimes(engage, disengage float64) {
 	self.follower.SetTimes(engage, disengage)
 }
 
+// Implements [Resetter].
+func (self *Tailer) Reset() {
+	self.Parametrized.Reset()
+	self.follower.Reset()
+	self.corrector.Reset()
+	self.moving = false
+}
+
+// Implements [Settler].
+func (self *Tailer) IsSettled() bool {
+	return !self.moving && self.corrector.speedX == 0.0 && self.corrector.speedY == 0.0
+}
+
 // Implements [Tracker].
 func (self *Tailer) Update(currentX, currentY, targetX, targetY, prevSpeedX, prevSpeedY float64) (float64, float64) {
 	// pre-subtract correction
 	w, h := internal.GetResolution()
 	w64, h64 := float64(w), float64(h)
 	zoom := internal.GetCurrentZoom()
-	updateDelta := 1.0/(float64(internal.GetUPS()))
+	updateDelta := internal.GetUpdateDelta()
 	relCurrentX := currentX - (self.corrector.speedX*w64*updateDelta)/zoom
 	relCurrentY := currentY - (self.corrector.speedY*h64*updateDelta)/zoom
 
@@ -63,9 +81,10 @@ func (self *Tailer) Update(currentX, currentY, targetX, targetY, prevSpeedX, pre
 	   internal.Abs(changeX) < 0.12*updateDelta && internal.Abs(changeY) < 0.12*updateDelta &&
 		internal.Abs(targetX - (currentX + changeX)) < (0.25/zoom)*updateDelta &&
 		internal.Abs(targetY - (currentY + changeY)) < (0.25/zoom)*updateDelta {
-		return targetX - currentX, targetY - currentY
+		changeX, changeY = targetX - currentX, targetY - currentY
 	}
 
+	self.moving = (changeX != 0.0 || changeY != 0.0)
 	return changeX, changeY
 }
 
@@ -21,7 +21,7 @@ type Constant struct {
 	speedTransitionIni float64
 	speedTransitionEnd float64
 	speedTransitionLength TicksDuration
-	speedTransitionElapsed TicksDuration
+	speedTransitionElapsed float64 // in ticks
 	zoomCompensationDisabled bool
 }
 
@@ -63,7 +63,7 @@ func (self *Constant) Reset() {
 func (self *Constant) Update(currentZoom, targetZoom float64) float64 {
 	speed := self.getAndAdvanceCurrentSpeed()
 	if targetZoom == currentZoom { return 0.0 }
-	updateSpeed := (speed + constantDefaultSpeedOffset)*(1.0/float64(internal.GetUPS()))
+	updateSpeed := (speed + constantDefaultSpeedOffset)*internal.GetUpdateDelta()
 	if !self.zoomCompensationDisabled { updateSpeed *= currentZoom }
 	if currentZoom < targetZoom {
 		return min(updateSpeed, targetZoom - currentZoom)
@@ -74,8 +74,8 @@ func (self *Constant) Update(currentZoom, targetZoom float64) float64 {
 
 func (self *Constant) getCurrentSpeed() float64 {
 	var speed float64
-	if self.speedTransitionElapsed < self.speedTransitionLength {
-		t := float64(self.speedTransitionElapsed)/float64(self.speedTransitionLength)
+	if self.speedTransitionElapsed < float64(self.speedTransitionLength) {
+		t := self.speedTransitionElapsed/float64(self.speedTransitionLength)
 		speed = internal.LinearInterp(self.speedTransitionIni, self.speedTransitionEnd, t)
 	} else {
 		speed = self.speedTransitionEnd
@@ -85,9 +85,9 @@ func (self *Constant) getCurrentSpeed() float64 {
 
 func (self *Constant) getAndAdvanceCurrentSpeed() float64 {
 	speed := self.getCurrentSpeed()
-	if self.speedTransitionElapsed < self.speedTransitionLength {
-		self.speedTransitionElapsed += TicksDuration(internal.GetTPU())
-		self.speedTransitionElapsed  = min(self.speedTransitionElapsed, self.speedTransitionLength)
+	if self.speedTransitionElapsed < float64(self.speedTransitionLength) {
+		self.speedTransitionElapsed += internal.GetTickAdvance()
+		self.speedTransitionElapsed  = min(self.speedTransitionElapsed, float64(self.speedTransitionLength))
 	}
 	return speed
 }
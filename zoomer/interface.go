@@ -41,5 +41,13 @@ type Zoomer interface {
 	Update(currentZoom, targetZoom float64) (change float64)
 }
 
+// An optional interface for zoomers that can report whether
+// they have come to rest. This is mostly relevant for spring
+// based zoomers, which can momentarily reach the target while
+// still oscillating around it.
+type Settler interface {
+	IsSettled() bool
+}
+
 // Alias for mipix.TicksDuration.
 type TicksDuration = internal.TicksDuration
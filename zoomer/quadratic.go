@@ -48,6 +48,11 @@ func (self *Quadratic) Reset() {
 	self.speed = 0.0
 }
 
+// Implements [Settler].
+func (self *Quadratic) IsSettled() bool {
+	return self.speed == 0.0
+}
+
 // Implements [Zoomer].
 func (self *Quadratic) Update(currentZoom, targetZoom float64) float64 {
 	if currentZoom == targetZoom { return 0.0 }
@@ -63,7 +68,7 @@ func (self *Quadratic) Update(currentZoom, targetZoom float64) float64 {
 	target := internal.Abs(distance)
 	
 	// update speed
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	if predicted < target {
 		if distance >= 0 {
 			self.speed += self.acceleration*updateDelta
@@ -30,7 +30,7 @@ func (self *RoughLinear) Reset() {
 func (self *RoughLinear) Update(currentZoom, targetZoom float64) float64 {
 	const MaxZoomTracking float64 = 5.0
 
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	if targetZoom != self.adjustedTarget {
 		var dir float64 = 1.0
 		if targetZoom < self.adjustedTarget { dir = -1.0 }
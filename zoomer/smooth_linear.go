@@ -38,7 +38,7 @@ func (self *SmoothLinear) Update(currentZoom, targetZoom float64) float64 {
 	//   still some edge cases, but we smooth that with an extra speed
 	//   interpolation.
 
-	updateDelta := 1.0/float64(internal.GetUPS())
+	updateDelta := internal.GetUpdateDelta()
 	if targetZoom != self.adjustedTarget {
 		distance := targetZoom - self.adjustedTarget
 		normDist := internal.Clamp(distance, -MaxZoomTracking, MaxZoomTracking)
@@ -3,6 +3,7 @@ package zoomer
 import "github.com/tinne26/mipix/internal"
 
 var _ Zoomer = (*Spring)(nil)
+var _ Settler = (*Spring)(nil)
 
 // Springy zoom. By default, it barely overshoots, but
 // you can set it to be more or less bouncy if you want.
@@ -68,6 +69,11 @@ func (self *Spring) Reset() {
 	self.speed = 0.0
 }
 
+// Implements [Settler].
+func (self *Spring) IsSettled() bool {
+	return self.speed == 0.0
+}
+
 // Implements [Zoomer].
 func (self *Spring) Update(currentZoom, targetZoom float64) float64 {
 	if currentZoom == targetZoom && self.speed == 0.0 { return 0.0 }
@@ -78,7 +84,7 @@ func (self *Spring) Update(currentZoom, targetZoom float64) float64 {
 	
 	// clean up case, don't keep oscillating on super small
 	// changes, it interferes with efficient GPU usage
-	if internal.Abs(targetZoom - newPosition) < 0.001 && internal.Abs(newSpeed) < (1.0/float64(internal.GetUPS())) {
+	if internal.Abs(targetZoom - newPosition) < 0.001 && internal.Abs(newSpeed) < internal.GetUpdateDelta() {
 		self.speed = 0.0
 		return targetZoom - currentZoom
 	}